@@ -4,6 +4,8 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -24,6 +26,61 @@ type Config struct {
 	// Server
 	ServerPort string
 
+	// Public web app base URL used for share links and QR codes
+	PublicWebURL string
+
+	// Due-date reminders for vaccinations and medications
+	ReminderCron        string
+	ReminderWindowsDays []int
+
+	// Pet data exports: pets with more gallery files than ExportSyncMaxFiles are built in the
+	// background; archives are removed ExportRetentionHours after they are built
+	ExportSyncMaxFiles   int
+	ExportRetentionHours int
+	ExportCleanupCron    string
+
+	// Background jobs run in-process, so a restart loses them: one still pending or
+	// running, or a gallery item still processing, after BackgroundJobTimeout is marked
+	// failed on JobSweepCron and at startup
+	BackgroundJobTimeout time.Duration
+	JobSweepCron         string
+
+	// Uploads: per-file size cap, files per request and parallel transfers to storage
+	UploadMaxFileSize int64
+	UploadMaxFiles    int
+	UploadConcurrency int
+
+	// Photos stripped and resized at once by the image pipeline
+	ImageProcessingConcurrency int
+
+	// Gallery videos: size and length caps, and the ffmpeg tools used to inspect them
+	VideoMaxFileSize int64
+	VideoMaxDuration time.Duration
+	FFmpegPath       string
+	FFprobePath      string
+
+	// Links to stored media are presigned and expire, except that public pets are
+	// served under MediaPublicBaseURL (a CDN or public origin) when it is set
+	PresignedURLExpiry time.Duration
+	MediaPublicBaseURL string
+
+	// Direct-to-storage uploads: how long an upload policy is valid, and how often
+	// uploads that were never completed are removed
+	DirectUploadExpiry time.Duration
+	UploadCleanupCron  string
+
+	// Storage backend: minio, local (files under StorageLocalPath) or memory. The local
+	// and memory backends serve their objects through the API under StorageBaseURL.
+	StorageBackend   string
+	StorageLocalPath string
+	StorageBaseURL   string
+
+	// Storage reconciliation: how often unreferenced objects under pet/ are removed, how
+	// old they must be first, and whether the job only reports what it would remove
+	StorageReconcileCron   string
+	StorageOrphanGrace     time.Duration
+	StorageReconcileDryRun bool
+
 	// MinIO
 	MinioEndpoint  string
 	MinioAccessKey string
@@ -42,6 +99,23 @@ func LoadConfig() {
 
 	debug, _ := strconv.ParseBool(getEnv("DEBUG", "false"))
 	minioUseSSL, _ := strconv.ParseBool(getEnv("MINIO_USE_SSL", "false"))
+	exportSyncMaxFiles, _ := strconv.Atoi(getEnv("EXPORT_SYNC_MAX_FILES", "50"))
+	exportRetentionHours, _ := strconv.Atoi(getEnv("EXPORT_RETENTION_HOURS", "72"))
+	uploadMaxFileSizeMB, _ := strconv.ParseInt(getEnv("UPLOAD_MAX_FILE_SIZE_MB", "50"), 10, 64)
+	uploadMaxFiles, _ := strconv.Atoi(getEnv("UPLOAD_MAX_FILES", "10"))
+	uploadConcurrency, _ := strconv.Atoi(getEnv("UPLOAD_CONCURRENCY", "4"))
+	if uploadConcurrency < 1 {
+		uploadConcurrency = 1
+	}
+	imageProcessingConcurrency, _ := strconv.Atoi(getEnv("IMAGE_PROCESSING_CONCURRENCY", "2"))
+	videoMaxFileSizeMB, _ := strconv.ParseInt(getEnv("VIDEO_MAX_FILE_SIZE_MB", "200"), 10, 64)
+	videoMaxDurationSeconds, _ := strconv.Atoi(getEnv("VIDEO_MAX_DURATION_SECONDS", "180"))
+	presignedURLExpiryMinutes, _ := strconv.Atoi(getEnv("PRESIGNED_URL_EXPIRY_MINUTES", "60"))
+	directUploadExpiryMinutes, _ := strconv.Atoi(getEnv("DIRECT_UPLOAD_EXPIRY_MINUTES", "30"))
+	backgroundJobTimeoutMinutes, _ := strconv.Atoi(getEnv("BACKGROUND_JOB_TIMEOUT_MINUTES", "60"))
+	serverPort := getEnv("SERVER_PORT", "8001")
+	storageOrphanGraceHours, _ := strconv.Atoi(getEnv("STORAGE_ORPHAN_GRACE_HOURS", "24"))
+	storageReconcileDryRun, _ := strconv.ParseBool(getEnv("STORAGE_RECONCILE_DRY_RUN", "false"))
 
 	AppConfig = &Config{
 		ProjectName: getEnv("PROJECT_NAME", "Pet Service API"),
@@ -55,7 +129,44 @@ func LoadConfig() {
 		DBPassword: getEnv("POSTGRES_PASSWORD", "postgres"),
 		DBName:     getEnv("POSTGRES_DB", "pet_service"),
 
-		ServerPort: getEnv("SERVER_PORT", "8001"),
+		ServerPort: serverPort,
+
+		PublicWebURL: strings.TrimRight(getEnv("PUBLIC_WEB_URL", "http://localhost:3000"), "/"),
+
+		ReminderCron:        getEnv("REMINDER_CRON", "0 8 * * *"),
+		ReminderWindowsDays: parseIntList(getEnv("REMINDER_WINDOWS_DAYS", "30,7,1")),
+
+		ExportSyncMaxFiles:   exportSyncMaxFiles,
+		ExportRetentionHours: exportRetentionHours,
+		ExportCleanupCron:    getEnv("EXPORT_CLEANUP_CRON", "0 * * * *"),
+
+		BackgroundJobTimeout: time.Duration(backgroundJobTimeoutMinutes) * time.Minute,
+		JobSweepCron:         getEnv("JOB_SWEEP_CRON", "*/15 * * * *"),
+
+		UploadMaxFileSize: uploadMaxFileSizeMB << 20,
+		UploadMaxFiles:    uploadMaxFiles,
+		UploadConcurrency: uploadConcurrency,
+
+		ImageProcessingConcurrency: imageProcessingConcurrency,
+
+		VideoMaxFileSize: videoMaxFileSizeMB << 20,
+		VideoMaxDuration: time.Duration(videoMaxDurationSeconds) * time.Second,
+		FFmpegPath:       getEnv("FFMPEG_PATH", "ffmpeg"),
+		FFprobePath:      getEnv("FFPROBE_PATH", "ffprobe"),
+
+		PresignedURLExpiry: time.Duration(presignedURLExpiryMinutes) * time.Minute,
+		MediaPublicBaseURL: strings.TrimRight(getEnv("MEDIA_PUBLIC_BASE_URL", ""), "/"),
+
+		DirectUploadExpiry: time.Duration(directUploadExpiryMinutes) * time.Minute,
+		UploadCleanupCron:  getEnv("UPLOAD_CLEANUP_CRON", "*/15 * * * *"),
+
+		StorageBackend:   strings.ToLower(getEnv("STORAGE_BACKEND", "minio")),
+		StorageLocalPath: getEnv("STORAGE_LOCAL_PATH", "./data/storage"),
+		StorageBaseURL:   strings.TrimRight(getEnv("STORAGE_BASE_URL", "http://localhost:"+serverPort+"/api/v1/blobs"), "/"),
+
+		StorageReconcileCron:   getEnv("STORAGE_RECONCILE_CRON", "0 4 * * *"),
+		StorageOrphanGrace:     time.Duration(storageOrphanGraceHours) * time.Hour,
+		StorageReconcileDryRun: storageReconcileDryRun,
 
 		MinioEndpoint:  getEnv("MINIO_ENDPOINT", "localhost:9000"),
 		MinioAccessKey: getEnv("MINIO_ACCESS_KEY", "minioadmin"),
@@ -72,3 +183,16 @@ func getEnv(key, defaultValue string) string {
 	}
 	return value
 }
+
+// parseIntList parses a comma-separated list of non-negative integers, skipping invalid entries
+func parseIntList(value string) []int {
+	var result []int
+	for _, part := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || n < 0 {
+			continue
+		}
+		result = append(result, n)
+	}
+	return result
+}
@@ -1,9 +1,11 @@
 package container
 
 import (
+	"pet-service/config"
 	"pet-service/handler"
 	"pet-service/repository"
 	"pet-service/service"
+	"pet-service/storage"
 
 	"gorm.io/gorm"
 )
@@ -11,6 +13,7 @@ import (
 // Container holds all application dependencies
 type Container struct {
 	DB       *gorm.DB
+	Store    storage.BlobStore
 	Repos    *Repositories
 	Services *Services
 	Handlers *Handlers
@@ -18,48 +21,124 @@ type Container struct {
 
 // Repositories holds all repository instances
 type Repositories struct {
-	User repository.IUserRepository
-	Pet  repository.IPetRepository
+	User         repository.IUserRepository
+	Pet          repository.IPetRepository
+	Feed         repository.IFeedRepository
+	Notification repository.INotificationRepository
+	Medical      repository.IMedicalRepository
+	Reminder     repository.IReminderRepository
+	Catalog      repository.ICatalogRepository
+	Member       repository.IMemberRepository
+	Media        repository.IMediaRepository
+	Storage      repository.IStorageRepository
 }
 
 // Services holds all service instances
 type Services struct {
-	User        service.IUserService
-	Pet         service.IPetService
-	Appointment service.IAppointmentService
+	User         service.IUserService
+	Pet          service.IPetService
+	Appointment  service.IAppointmentService
+	Feed         service.IFeedService
+	Share        service.IShareService
+	Notification service.INotificationService
+	LostPet      service.ILostPetService
+	Medical      service.IMedicalService
+	Measurement  service.IMeasurementService
+	Catalog      service.ICatalogService
+	Pedigree     service.IPedigreeService
+	Member       service.IMemberService
+	Memorial     service.IMemorialService
+	Export       service.IExportService
+	Import       service.IImportService
+	Media        service.IMediaService
+	Storage      service.IStorageService
 }
 
 // Handlers holds all handler instances
 type Handlers struct {
-	User        *handler.UserHandler
-	Pet         *handler.PetHandler
-	Appointment *handler.AppointmentHandler
+	User         *handler.UserHandler
+	Pet          *handler.PetHandler
+	Appointment  *handler.AppointmentHandler
+	Feed         *handler.FeedHandler
+	Share        *handler.ShareHandler
+	Notification *handler.NotificationHandler
+	LostPet      *handler.LostPetHandler
+	Medical      *handler.MedicalHandler
+	Measurement  *handler.MeasurementHandler
+	Catalog      *handler.CatalogHandler
+	Pedigree     *handler.PedigreeHandler
+	Member       *handler.MemberHandler
+	Memorial     *handler.MemorialHandler
+	Export       *handler.ExportHandler
+	Import       *handler.ImportHandler
+	Media        *handler.MediaHandler
+	Blob         *handler.BlobHandler
 }
 
-// NewContainer creates and wires up all dependencies
-func NewContainer(db *gorm.DB) *Container {
+// NewContainer creates and wires up all dependencies; services keep their files in store
+func NewContainer(db *gorm.DB, store storage.BlobStore) *Container {
 	// Initialize repositories
 	repos := &Repositories{
-		User: repository.NewUserRepository(db),
-		Pet:  repository.NewPetRepository(db),
+		User:         repository.NewUserRepository(db),
+		Pet:          repository.NewPetRepository(db),
+		Feed:         repository.NewFeedRepository(db),
+		Notification: repository.NewNotificationRepository(db),
+		Medical:      repository.NewMedicalRepository(db),
+		Reminder:     repository.NewReminderRepository(db),
+		Catalog:      repository.NewCatalogRepository(db),
+		Member:       repository.NewMemberRepository(db),
+		Media:        repository.NewMediaRepository(db),
+		Storage:      repository.NewStorageRepository(db),
 	}
 
+	// Gallery videos are probed and given posters with the ffmpeg tools
+	videoProcessor := service.NewVideoProcessor(config.AppConfig.FFmpegPath, config.AppConfig.FFprobePath)
+
 	// Initialize services with repository interfaces
 	services := &Services{
-		User:        service.NewUserService(repos.User),
-		Pet:         service.NewPetService(repos.Pet),
-		Appointment: service.NewAppointmentService(db),
+		User:         service.NewUserService(repos.User, repos.Pet, repos.Feed),
+		Pet:          service.NewPetService(repos.Pet, repos.Media, repos.Feed, repos.Catalog, videoProcessor, store),
+		Appointment:  service.NewAppointmentService(db, repos.Pet),
+		Feed:         service.NewFeedService(repos.Feed, repos.Pet, store),
+		Share:        service.NewShareService(repos.Pet, store),
+		Notification: service.NewNotificationService(repos.Notification),
+		Medical:      service.NewMedicalService(repos.Medical, repos.Pet, repos.User),
+		Measurement:  service.NewMeasurementService(repos.Pet, repos.Medical, repos.User),
+		Catalog:      service.NewCatalogService(repos.Catalog),
+		Pedigree:     service.NewPedigreeService(repos.Pet, repos.Feed, store),
+		Memorial:     service.NewMemorialService(repos.Pet, repos.Feed, store),
+		Import:       service.NewImportService(repos.Pet, repos.Catalog, store),
+		Media:        service.NewMediaService(repos.Media, repos.Pet, repos.Feed, store),
+		Storage:      service.NewStorageService(repos.Storage, store),
 	}
+	services.LostPet = service.NewLostPetService(repos.Pet, services.Notification, store)
+	services.Member = service.NewMemberService(repos.Member, repos.Pet, repos.User, services.Notification, store)
+	services.Export = service.NewExportService(repos.Pet, repos.User, repos.Medical, services.Notification, store)
 
 	// Initialize handlers with service interfaces
 	handlers := &Handlers{
-		User:        handler.NewUserHandler(services.User),
-		Pet:         handler.NewPetHandler(services.Pet, db),
-		Appointment: handler.NewAppointmentHandler(services.Appointment),
+		User:         handler.NewUserHandler(services.User),
+		Pet:          handler.NewPetHandler(services.Pet, db),
+		Appointment:  handler.NewAppointmentHandler(services.Appointment),
+		Feed:         handler.NewFeedHandler(services.Feed),
+		Share:        handler.NewShareHandler(services.Share),
+		Notification: handler.NewNotificationHandler(services.Notification),
+		LostPet:      handler.NewLostPetHandler(services.LostPet),
+		Medical:      handler.NewMedicalHandler(services.Medical),
+		Measurement:  handler.NewMeasurementHandler(services.Measurement),
+		Catalog:      handler.NewCatalogHandler(services.Catalog),
+		Pedigree:     handler.NewPedigreeHandler(services.Pedigree),
+		Member:       handler.NewMemberHandler(services.Member),
+		Memorial:     handler.NewMemorialHandler(services.Memorial),
+		Export:       handler.NewExportHandler(services.Export),
+		Import:       handler.NewImportHandler(services.Import),
+		Media:        handler.NewMediaHandler(services.Media),
+		Blob:         handler.NewBlobHandler(store),
 	}
 
 	return &Container{
 		DB:       db,
+		Store:    store,
 		Repos:    repos,
 		Services: services,
 		Handlers: handlers,
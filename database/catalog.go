@@ -0,0 +1,219 @@
+package database
+
+import (
+	"log"
+	"pet-service/models"
+	"pet-service/utils"
+)
+
+type catalogSeed struct {
+	code    string
+	name    string
+	nameVi  string
+	aliases []string
+}
+
+var speciesSeed = []catalogSeed{
+	{"dog", "Dog", "Chó", []string{"dogs", "puppy", "canine", "cún", "chó con"}},
+	{"cat", "Cat", "Mèo", []string{"cats", "kitten", "kitty", "feline", "mèo con"}},
+	{"bird", "Bird", "Chim", []string{"birds"}},
+	{"rabbit", "Rabbit", "Thỏ", []string{"rabbits", "bunny"}},
+	{"hamster", "Hamster", "Chuột hamster", []string{"hamsters"}},
+	{"fish", "Fish", "Cá", []string{"fishes"}},
+	{utils.CatalogOther, "Other", "Khác", nil},
+}
+
+var breedSeed = map[string][]catalogSeed{
+	"dog": {
+		{"labrador_retriever", "Labrador Retriever", "Chó Labrador", []string{"labrador", "lab"}},
+		{"golden_retriever", "Golden Retriever", "Chó Golden", []string{"golden"}},
+		{"german_shepherd", "German Shepherd", "Chó Becgie", []string{"alsatian", "gsd", "becgie", "béc giê"}},
+		{"siberian_husky", "Siberian Husky", "Chó Husky", []string{"husky"}},
+		{"beagle", "Beagle", "Chó Beagle", nil},
+		{"poodle", "Poodle", "Chó Poodle", []string{"toy poodle", "chó xù"}},
+		{"pug", "Pug", "Chó Pug", nil},
+		{"chihuahua", "Chihuahua", "Chó Chihuahua", nil},
+		{"corgi", "Corgi", "Chó Corgi", []string{"welsh corgi", "pembroke welsh corgi"}},
+		{"shiba_inu", "Shiba Inu", "Chó Shiba", []string{"shiba"}},
+		{"phu_quoc_ridgeback", "Phu Quoc Ridgeback", "Chó Phú Quốc", []string{"phu quoc"}},
+		{"dog_mixed", "Mixed Breed", "Chó lai", []string{"mixed", "mutt", "chó ta", "lai"}},
+	},
+	"cat": {
+		{"persian", "Persian", "Mèo Ba Tư", []string{"ba tư"}},
+		{"maine_coon", "Maine Coon", "Mèo Maine Coon", nil},
+		{"siamese", "Siamese", "Mèo Xiêm", []string{"xiêm"}},
+		{"british_shorthair", "British Shorthair", "Mèo Anh lông ngắn", []string{"bsh", "anh lông ngắn"}},
+		{"scottish_fold", "Scottish Fold", "Mèo tai cụp", []string{"tai cụp"}},
+		{"sphynx", "Sphynx", "Mèo không lông", []string{"hairless"}},
+		{"bengal", "Bengal", "Mèo Bengal", nil},
+		{"ragdoll", "Ragdoll", "Mèo Ragdoll", nil},
+		{"cat_mixed", "Mixed Breed", "Mèo lai", []string{"mixed", "domestic shorthair", "mèo ta", "lai"}},
+	},
+}
+
+// seedCatalog loads the initial species/breed catalog on first start
+func seedCatalog() {
+	var count int64
+	DB.Model(&models.Species{}).Count(&count)
+	if count > 0 {
+		return
+	}
+
+	for i, seed := range speciesSeed {
+		species := models.Species{Code: seed.code, Name: seed.name, SortOrder: i}
+		species.IsActive = true
+		DB.Create(&species)
+		createCatalogTerms(utils.CatalogEntitySpecies, seed)
+
+		for _, breedSeed := range breedSeed[seed.code] {
+			breed := models.Breed{Code: breedSeed.code, SpeciesCode: seed.code, Name: breedSeed.name}
+			breed.IsActive = true
+			DB.Create(&breed)
+			createCatalogTerms(utils.CatalogEntityBreed, breedSeed)
+		}
+	}
+
+	log.Println("Species and breed catalog seeded")
+}
+
+func createCatalogTerms(entityType string, seed catalogSeed) {
+	terms := []models.CatalogTerm{
+		{EntityType: entityType, Code: seed.code, Locale: "en", Term: seed.name},
+		{EntityType: entityType, Code: seed.code, Locale: "vi", Term: seed.nameVi},
+	}
+	for _, alias := range seed.aliases {
+		terms = append(terms, models.CatalogTerm{EntityType: entityType, Code: seed.code, Term: alias, IsAlias: true})
+	}
+
+	seen := make(map[string]bool)
+	for i := range terms {
+		terms[i].Normalized = utils.NormalizeTerm(terms[i].Term)
+		if seen[terms[i].Normalized] {
+			continue
+		}
+		seen[terms[i].Normalized] = true
+		terms[i].IsActive = true
+		DB.Create(&terms[i])
+	}
+}
+
+// migratePetCatalog maps legacy free-text pet types and breeds to catalog codes.
+// Values that can't be matched unambiguously are left as they are and retried on the next start.
+func migratePetCatalog() {
+	var pets []models.Pet
+	DB.Where(`is_active = ? AND (
+			(COALESCE(species_code, '') = '' AND COALESCE(type, '') <> '')
+			OR (COALESCE(breed_code, '') = '' AND COALESCE(breed, '') <> '' AND COALESCE(species_code, '') NOT IN ('', ?)))`,
+		true, utils.CatalogOther).
+		Find(&pets)
+	if len(pets) == 0 {
+		return
+	}
+
+	var species []models.Species
+	var breeds []models.Breed
+	var terms []models.CatalogTerm
+	DB.Where("is_active = ?", true).Find(&species)
+	DB.Where("is_active = ?", true).Find(&breeds)
+	DB.Where("is_active = ?", true).Find(&terms)
+
+	speciesNames := make(map[string]string)
+	for _, s := range species {
+		speciesNames[s.Code] = s.Name
+	}
+	breedsByCode := make(map[string]models.Breed)
+	for _, b := range breeds {
+		breedsByCode[b.Code] = b
+	}
+
+	// normalized term -> codes, with breeds grouped by species
+	speciesTerms := make(map[string][]string)
+	breedTerms := make(map[string]map[string][]string)
+	for _, t := range terms {
+		switch t.EntityType {
+		case utils.CatalogEntitySpecies:
+			speciesTerms[t.Normalized] = appendUnique(speciesTerms[t.Normalized], t.Code)
+		case utils.CatalogEntityBreed:
+			speciesCode := breedsByCode[t.Code].SpeciesCode
+			if breedTerms[speciesCode] == nil {
+				breedTerms[speciesCode] = make(map[string][]string)
+			}
+			breedTerms[speciesCode][t.Normalized] = appendUnique(breedTerms[speciesCode][t.Normalized], t.Code)
+		}
+	}
+
+	mapped := 0
+	for _, pet := range pets {
+		updates := map[string]interface{}{}
+
+		speciesCode := pet.SpeciesCode
+		if speciesCode == "" {
+			speciesCode = matchCatalogTerm(speciesTerms, utils.NormalizeTerm(pet.Type))
+			if speciesCode == "" || speciesCode == utils.CatalogOther {
+				continue
+			}
+			updates["species_code"] = speciesCode
+			updates["type"] = speciesNames[speciesCode]
+		}
+
+		if pet.BreedCode == "" && pet.Breed != "" {
+			if breedCode := matchCatalogTerm(breedTerms[speciesCode], utils.NormalizeTerm(pet.Breed)); breedCode != "" {
+				updates["breed_code"] = breedCode
+				updates["breed"] = breedsByCode[breedCode].Name
+			}
+		}
+
+		if len(updates) > 0 {
+			DB.Model(&models.Pet{}).Where("id = ?", pet.ID).Updates(updates)
+			mapped++
+		}
+	}
+
+	log.Printf("Catalog migration: mapped %d of %d pets", mapped, len(pets))
+}
+
+// matchCatalogTerm finds the code for a normalized value: an exact term first,
+// then a single term within a small edit distance to absorb typos
+func matchCatalogTerm(terms map[string][]string, value string) string {
+	if value == "" {
+		return ""
+	}
+	if codes := terms[value]; len(codes) == 1 {
+		return codes[0]
+	} else if len(codes) > 1 {
+		return ""
+	}
+
+	maxDistance := 0
+	switch {
+	case len([]rune(value)) >= 8:
+		maxDistance = 2
+	case len([]rune(value)) >= 4:
+		maxDistance = 1
+	}
+	if maxDistance == 0 {
+		return ""
+	}
+
+	var candidates []string
+	for term, codes := range terms {
+		if utils.Levenshtein(term, value) <= maxDistance {
+			for _, code := range codes {
+				candidates = appendUnique(candidates, code)
+			}
+		}
+	}
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+	return ""
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -45,19 +45,48 @@ func ConnectDatabase() {
 		&models.UserRole{},
 		&models.Pet{},
 		&models.Media{},
+		&models.MediaUpload{},
+		&models.MediaBlob{},
+		&models.MediaAlbum{},
 		&models.PetLifeEvent{},
 		&models.Comment{},
 		&models.Appointment{},
 		&models.LoginHistory{},
 		&models.TokenBlacklist{},
+		&models.PetFollow{},
+		&models.PetActivity{},
+		&models.PetShareLink{},
+		&models.PetSighting{},
+		&models.PetMember{},
+		&models.PetTribute{},
+		&models.PetTransfer{},
+		&models.PetExport{},
+		&models.PetImport{},
+		&models.Notification{},
+		&models.Vaccination{},
+		&models.Treatment{},
+		&models.Medication{},
+		&models.ReminderDelivery{},
+		&models.PetMeasurement{},
+		&models.BreedReferenceRange{},
+		&models.Species{},
+		&models.Breed{},
+		&models.CatalogTerm{},
 	); err != nil {
 		log.Fatalf("Failed to migrate database: %v", err)
 	}
 
+	migratePetSearch()
+	migratePetExternalIDs()
+	migrateMediaKeys()
+
 	log.Println("Database migration completed")
 
 	// Seed initial data
 	seedData()
+	seedBreedReferenceRanges()
+	seedCatalog()
+	migratePetCatalog()
 }
 
 func GetDB() *gorm.DB {
@@ -0,0 +1,36 @@
+package database
+
+import (
+	"fmt"
+	"log"
+	"pet-service/config"
+)
+
+// migrateMediaKeys rewrites media links stored before object keys were, such as
+// http://minio:9000/bucket/pet/<id>/<file>, to their keys. Links to another host are
+// left as they are. It only touches rows still holding such a link, so it is safe to rerun.
+func migrateMediaKeys() {
+	cfg := config.AppConfig
+	prefixes := []string{
+		fmt.Sprintf("http://%s/%s/", cfg.MinioEndpoint, cfg.MinioBucket),
+		fmt.Sprintf("https://%s/%s/", cfg.MinioEndpoint, cfg.MinioBucket),
+	}
+	keyColumns := [][2]string{{"pets", "avt_url"}, {"medias", "url"}, {"pet_sightings", "photo_url"}}
+	variantColumns := [][2]string{{"pets", "avt_variants"}, {"medias", "variants"}}
+
+	for _, prefix := range prefixes {
+		for _, c := range keyColumns {
+			statement := fmt.Sprintf(`UPDATE %[1]s SET %[2]s = substr(%[2]s, ?) WHERE starts_with(%[2]s, ?)`, c[0], c[1])
+			if err := DB.Exec(statement, len(prefix)+1, prefix).Error; err != nil {
+				log.Fatalf("Failed to migrate %s.%s to object keys: %v", c[0], c[1], err)
+			}
+		}
+		// Variants are JSON maps of name to link
+		for _, c := range variantColumns {
+			statement := fmt.Sprintf(`UPDATE %[1]s SET %[2]s = replace(%[2]s, ?, '"') WHERE position(? in %[2]s) > 0`, c[0], c[1])
+			if err := DB.Exec(statement, `"`+prefix, `"`+prefix).Error; err != nil {
+				log.Fatalf("Failed to migrate %s.%s to object keys: %v", c[0], c[1], err)
+			}
+		}
+	}
+}
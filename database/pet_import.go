@@ -0,0 +1,15 @@
+package database
+
+import "log"
+
+// migratePetExternalIDs makes a bulk-imported row unique per owner, so imports of the
+// same file running at once can't both create the pet. Deleted pets and pets entered
+// by hand don't count.
+func migratePetExternalIDs() {
+	statement := `CREATE UNIQUE INDEX IF NOT EXISTS idx_pets_user_external_id ON pets (user_id, external_id)
+		WHERE external_id <> '' AND is_active`
+
+	if err := DB.Exec(statement).Error; err != nil {
+		log.Fatalf("Failed to migrate pet external IDs: %v", err)
+	}
+}
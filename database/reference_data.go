@@ -0,0 +1,41 @@
+package database
+
+import (
+	"log"
+	"pet-service/models"
+)
+
+// seedBreedReferenceRanges loads typical adult sizes for common breeds on first start
+func seedBreedReferenceRanges() {
+	var count int64
+	DB.Model(&models.BreedReferenceRange{}).Count(&count)
+	if count > 0 {
+		return
+	}
+
+	kg := func(v float64) *float64 { return &v }
+	ranges := []models.BreedReferenceRange{
+		{Type: "Dog", Breed: "Labrador Retriever", MinWeightKg: kg(25), MaxWeightKg: kg(36), MinHeightCm: kg(55), MaxHeightCm: kg(62)},
+		{Type: "Dog", Breed: "Golden Retriever", MinWeightKg: kg(25), MaxWeightKg: kg(34), MinHeightCm: kg(51), MaxHeightCm: kg(61)},
+		{Type: "Dog", Breed: "German Shepherd", MinWeightKg: kg(22), MaxWeightKg: kg(40), MinHeightCm: kg(55), MaxHeightCm: kg(65)},
+		{Type: "Dog", Breed: "Siberian Husky", MinWeightKg: kg(16), MaxWeightKg: kg(27), MinHeightCm: kg(51), MaxHeightCm: kg(60)},
+		{Type: "Dog", Breed: "Beagle", MinWeightKg: kg(9), MaxWeightKg: kg(11), MinHeightCm: kg(33), MaxHeightCm: kg(41)},
+		{Type: "Dog", Breed: "Poodle", MinWeightKg: kg(18), MaxWeightKg: kg(32), MinHeightCm: kg(38), MaxHeightCm: kg(60)},
+		{Type: "Dog", Breed: "Pug", MinWeightKg: kg(6), MaxWeightKg: kg(8), MinHeightCm: kg(25), MaxHeightCm: kg(36)},
+		{Type: "Dog", Breed: "Chihuahua", MinWeightKg: kg(1.5), MaxWeightKg: kg(3), MinHeightCm: kg(15), MaxHeightCm: kg(23)},
+		{Type: "Dog", Breed: "Corgi", MinWeightKg: kg(10), MaxWeightKg: kg(14), MinHeightCm: kg(25), MaxHeightCm: kg(30)},
+		{Type: "Dog", Breed: "Shiba Inu", MinWeightKg: kg(8), MaxWeightKg: kg(11), MinHeightCm: kg(35), MaxHeightCm: kg(43)},
+		{Type: "Cat", Breed: "Persian", MinWeightKg: kg(3), MaxWeightKg: kg(5.5)},
+		{Type: "Cat", Breed: "Maine Coon", MinWeightKg: kg(5), MaxWeightKg: kg(11)},
+		{Type: "Cat", Breed: "Siamese", MinWeightKg: kg(2.5), MaxWeightKg: kg(5.5)},
+		{Type: "Cat", Breed: "British Shorthair", MinWeightKg: kg(4), MaxWeightKg: kg(8)},
+	}
+	for i := range ranges {
+		ranges[i].IsActive = true
+		ranges[i].MinBodyConditionScore = 4
+		ranges[i].MaxBodyConditionScore = 5
+		DB.Create(&ranges[i])
+	}
+
+	log.Printf("Seeded %d breed reference ranges", len(ranges))
+}
@@ -0,0 +1,22 @@
+package database
+
+import "log"
+
+// migratePetSearch adds the full-text search column used by the pet list.
+// It is a generated column so gorm never writes it; name ranks above breed, breed above description.
+func migratePetSearch() {
+	statements := []string{
+		`ALTER TABLE pets ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+			setweight(to_tsvector('simple', coalesce(name, '')), 'A') ||
+			setweight(to_tsvector('simple', coalesce(breed, '')), 'B') ||
+			setweight(to_tsvector('simple', coalesce(description, '')), 'C')
+		) STORED`,
+		`CREATE INDEX IF NOT EXISTS idx_pets_search_vector ON pets USING GIN (search_vector)`,
+	}
+
+	for _, statement := range statements {
+		if err := DB.Exec(statement).Error; err != nil {
+			log.Fatalf("Failed to migrate pet search: %v", err)
+		}
+	}
+}
@@ -19,11 +19,26 @@ type MessageResponse struct {
 	Message string `json:"message"`
 }
 
+// PaginationMeta describes a page. Offset pages carry page and, when counted,
+// the totals; cursor pages carry next/prev cursors and total_items only when
+// include_total was requested.
 type PaginationMeta struct {
-	TotalItems int64 `json:"total_items"`
-	TotalPages int64 `json:"total_pages"`
-	Page       int   `json:"page"`
-	PageSize   int   `json:"page_size"`
+	TotalItems *int64 `json:"total_items,omitempty"`
+	TotalPages *int64 `json:"total_pages,omitempty"`
+	Page       int    `json:"page,omitempty"`
+	PageSize   int    `json:"page_size"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+}
+
+// PageQuery selects a page by offset (page) or by cursor. Send pagination=cursor
+// for the first cursor page, then the returned next_cursor/prev_cursor as cursor.
+type PageQuery struct {
+	Page         int    `form:"page,default=1" binding:"min=1"`
+	PageSize     int    `form:"page_size,default=10" binding:"min=1,max=100"`
+	Pagination   string `form:"pagination" binding:"omitempty,oneof=offset cursor"`
+	Cursor       string `form:"cursor"`
+	IncludeTotal bool   `form:"include_total"`
 }
 
 type PaginationResponse struct {
@@ -53,15 +68,22 @@ type LoginResponse struct {
 }
 
 type UserResponse struct {
-	ID          string   `json:"id"`
-	FirstName   string   `json:"first_name"`
-	LastName    string   `json:"last_name"`
-	Email       string   `json:"email"`
-	Phone       string   `json:"phone"`
-	IsAdmin     bool     `json:"is_admin"`
-	Avatar      string   `json:"avatar,omitempty"`
-	Roles       []string `json:"roles,omitempty"`
-	Permissions []string `json:"permissions,omitempty"`
+	ID              string   `json:"id"`
+	FirstName       string   `json:"first_name"`
+	LastName        string   `json:"last_name"`
+	Email           string   `json:"email"`
+	Phone           string   `json:"phone"`
+	IsAdmin         bool     `json:"is_admin"`
+	Avatar          string   `json:"avatar,omitempty"`
+	EmailVisibility string   `json:"email_visibility,omitempty"`
+	PhoneVisibility string   `json:"phone_visibility,omitempty"`
+	Roles           []string `json:"roles,omitempty"`
+	Permissions     []string `json:"permissions,omitempty"`
+}
+
+type UserPrivacyRequest struct {
+	EmailVisibility string `json:"email_visibility" binding:"required,oneof=public followers private"`
+	PhoneVisibility string `json:"phone_visibility" binding:"required,oneof=public followers private"`
 }
 
 type ChangePasswordRequest struct {
@@ -95,7 +117,52 @@ type PetCreateRequest struct {
 	DateOfDeath string `json:"date_of_death"`
 	Breed       string `json:"breed"`
 	Description string `json:"description"`
-	Type        string `json:"type" binding:"required"`
+	Type        string `json:"type" binding:"required_without=SpeciesCode"`
+	SpeciesCode string `json:"species_code" example:"dog"`
+	BreedCode   string `json:"breed_code" example:"labrador_retriever"`
+	Visibility  string `json:"visibility" binding:"omitempty,oneof=public followers private"`
+}
+
+// PetListQuery holds the pet list filters. Ages are whole years derived from
+// date_of_birth; sort defaults to relevance when searching, otherwise date_of_birth.
+// Cursor pages are always ordered by created_at, newest first.
+type PetListQuery struct {
+	PageQuery
+	Search   string `form:"search"`
+	Name     string `form:"name"`
+	Type     string `form:"type"`
+	Species  string `form:"species"`
+	Breed    string `form:"breed"`
+	Gender   string `form:"gender" binding:"omitempty,oneof=male female"`
+	MinAge   *int   `form:"min_age" binding:"omitempty,min=0"`
+	MaxAge   *int   `form:"max_age" binding:"omitempty,min=0"`
+	OwnerID  string `form:"owner_id"` // "me" for the current user
+	Status   string `form:"status" binding:"omitempty,oneof=alive deceased"`
+	HasPhoto *bool  `form:"has_photo"`
+	Sort     string `form:"sort" binding:"omitempty,oneof=relevance name date_of_birth created_at"`
+	Order    string `form:"order" binding:"omitempty,oneof=asc desc"`
+}
+
+// PetSearchMatch explains a full-text match. Snippets are HTML-escaped with the
+// matched words wrapped in <mark> tags.
+type PetSearchMatch struct {
+	Rank        float64 `json:"rank"`
+	Name        string  `json:"name"`
+	Breed       string  `json:"breed"`
+	Description string  `json:"description"`
+}
+
+// PetUpdateRequest is a partial update; omitted fields are left unchanged
+type PetUpdateRequest struct {
+	Name        *string `json:"name" binding:"omitempty,min=1,max=105"`
+	Gender      *bool   `json:"gender"`
+	DateOfBirth *string `json:"date_of_birth"`
+	DateOfDeath *string `json:"date_of_death"`
+	Breed       *string `json:"breed"`
+	Description *string `json:"description" binding:"omitempty,max=255"`
+	Type        *string `json:"type"`
+	SpeciesCode *string `json:"species_code"`
+	BreedCode   *string `json:"breed_code"`
 }
 
 type PetLifeEventRequest struct {
@@ -106,28 +173,61 @@ type PetLifeEventRequest struct {
 	Story    string `json:"story"`
 }
 
+type PetVisibilityRequest struct {
+	Visibility string `json:"visibility" binding:"required,oneof=public followers private"`
+}
+
+// PetOwnerResponse is the owner profile embedded in pet responses; contact
+// fields are only filled when the owner's privacy settings allow it
+type PetOwnerResponse struct {
+	ID        string `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	AvatarURL string `json:"avatar_url"`
+	Email     string `json:"email,omitempty"`
+	Phone     string `json:"phone,omitempty"`
+}
+
 type PetResponse struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Gender      bool   `json:"gender"`
-	DateOfBirth string `json:"date_of_birth"`
-	DateOfDeath string `json:"date_of_death"`
-	Breed       string `json:"breed"`
-	Description string `json:"description"`
-	Type        string `json:"type"`
-	AvtURL      string `json:"avt_url"`
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Gender      bool              `json:"gender"`
+	DateOfBirth string            `json:"date_of_birth"`
+	DateOfDeath string            `json:"date_of_death"`
+	Breed       string            `json:"breed"`
+	Description string            `json:"description"`
+	Type        string            `json:"type"`
+	SpeciesCode string            `json:"species_code"`
+	BreedCode   string            `json:"breed_code"`
+	AvtURL      string            `json:"avt_url"`
+	AvtVariants map[string]string `json:"avt_variants,omitempty"`
+	Visibility  string            `json:"visibility"`
+	IsMemorial  bool              `json:"is_memorial"`
+	Owner       *PetOwnerResponse `json:"owner,omitempty"`
+	Match       *PetSearchMatch   `json:"match,omitempty"`
 }
 
 type PetDetailResponse struct {
-	ID          string               `json:"id"`
-	Name        string               `json:"name"`
-	Gender      bool                 `json:"gender"`
-	DateOfBirth string               `json:"date_of_birth"`
-	DateOfDeath string               `json:"date_of_death"`
-	Breed       string               `json:"breed"`
-	Description string               `json:"description"`
-	Events      []PetLifeEventItem   `json:"events"`
-	Medias      []MediaItem          `json:"medias"`
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Gender      bool               `json:"gender"`
+	DateOfBirth string             `json:"date_of_birth"`
+	DateOfDeath string             `json:"date_of_death"`
+	Breed       string             `json:"breed"`
+	Description string             `json:"description"`
+	Type        string             `json:"type"`
+	SpeciesCode string             `json:"species_code"`
+	BreedCode   string             `json:"breed_code"`
+	AvtURL      string             `json:"avt_url"`
+	AvtVariants map[string]string  `json:"avt_variants,omitempty"`
+	Visibility  string             `json:"visibility"`
+	IsMemorial  bool               `json:"is_memorial"`
+	Owner       *PetOwnerResponse  `json:"owner,omitempty"`
+	Events      []PetLifeEventItem `json:"events"`
+	Medias      []MediaItem        `json:"medias"`
+	Cover       *MediaItem         `json:"cover,omitempty"`
+	// Latest weight/size reading, shown to owners and caretakers
+	LatestMeasurement *MeasurementItem `json:"latest_measurement,omitempty"`
 }
 
 type PetLifeEventItem struct {
@@ -138,15 +238,465 @@ type PetLifeEventItem struct {
 	Story    string `json:"story"`
 }
 
+// MediaItem is a gallery file; url and variants are set once a photo is processed
 type MediaItem struct {
-	ID  string `json:"id"`
-	URL string `json:"url"`
+	ID          string            `json:"id"`
+	URL         string            `json:"url"`
+	Type        string            `json:"type,omitempty"`
+	Status      string            `json:"status"`
+	Variants    map[string]string `json:"variants,omitempty"`
+	Duration    float64           `json:"duration,omitempty"` // seconds; videos only
+	Width       int               `json:"width,omitempty"`
+	Height      int               `json:"height,omitempty"`
+	PlaybackURL string            `json:"playback_url,omitempty"` // presigned, supports range requests
+	Caption     string            `json:"caption,omitempty"`
+	AlbumID     string            `json:"album_id,omitempty"`
+}
+
+// Gallery management DTOs
+type MediaUpdateRequest struct {
+	Caption *string `json:"caption" binding:"omitempty,max=500"`
+	AlbumID *string `json:"album_id"` // "" takes the item out of its album
+}
+
+type MediaOrderRequest struct {
+	MediaIDs []string `json:"media_ids" binding:"required,min=1,max=500,dive,required"`
+}
+
+type PetCoverRequest struct {
+	MediaID string `json:"media_id"` // "" clears the cover
+}
+
+type PetAvatarRequest struct {
+	MediaID string `json:"media_id" binding:"required"`
+}
+
+type MediaAlbumRequest struct {
+	Name        string `json:"name" binding:"required,max=105" example:"First summer"`
+	Description string `json:"description" binding:"max=255"`
+}
+
+type MediaAlbumUpdateRequest struct {
+	Name         *string `json:"name" binding:"omitempty,min=1,max=105"`
+	Description  *string `json:"description" binding:"omitempty,max=255"`
+	CoverMediaID *string `json:"cover_media_id"` // "" clears the cover
+}
+
+type MediaAlbumItem struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Cover       *MediaItem `json:"cover,omitempty"`
+	MediaCount  int64      `json:"media_count"`
+	CreatedAt   string     `json:"created_at"`
+}
+
+// Share link DTOs
+type ShareLinkResponse struct {
+	PetID     string `json:"pet_id"`
+	Slug      string `json:"slug"`
+	URL       string `json:"url"`
+	QRCodeURL string `json:"qr_code_url"`
+	CreatedAt string `json:"created_at"`
+}
+
+// PublicPetProfileResponse is what anyone scanning a pet's QR tag sees
+type PublicPetProfileResponse struct {
+	Name   string            `json:"name"`
+	Type   string            `json:"type"`
+	Breed  string            `json:"breed"`
+	Gender bool              `json:"gender"`
+	AvtURL string            `json:"avt_url"`
+	IsLost bool              `json:"is_lost"`
+	Owner  *PetOwnerResponse `json:"owner,omitempty"`
+}
+
+// Lost & found DTOs
+type PetLostRequest struct {
+	IsLost           bool     `json:"is_lost"`
+	LastSeenAt       string   `json:"last_seen_at"`
+	LastSeenLocation string   `json:"last_seen_location" binding:"max=255"`
+	Latitude         *float64 `json:"latitude" binding:"omitempty,latitude"`
+	Longitude        *float64 `json:"longitude" binding:"omitempty,longitude"`
+}
+
+type LostPetItem struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	Type             string   `json:"type"`
+	Breed            string   `json:"breed"`
+	Gender           bool     `json:"gender"`
+	AvtURL           string   `json:"avt_url"`
+	LostAt           string   `json:"lost_at"`
+	LastSeenAt       string   `json:"last_seen_at"`
+	LastSeenLocation string   `json:"last_seen_location"`
+	Latitude         *float64 `json:"latitude,omitempty"`
+	Longitude        *float64 `json:"longitude,omitempty"`
+}
+
+// SightingRequest is sent as multipart/form-data so a photo can be attached
+type SightingRequest struct {
+	Note            string   `form:"note" binding:"max=2000"`
+	SeenAt          string   `form:"seen_at"`
+	Location        string   `form:"location" binding:"max=255"`
+	Latitude        *float64 `form:"latitude" binding:"omitempty,latitude"`
+	Longitude       *float64 `form:"longitude" binding:"omitempty,longitude"`
+	ReporterName    string   `form:"reporter_name" binding:"max=105"`
+	ReporterContact string   `form:"reporter_contact" binding:"max=105"`
+}
+
+type SightingResponse struct {
+	ID              string            `json:"id"`
+	PetID           string            `json:"pet_id"`
+	Note            string            `json:"note"`
+	SeenAt          string            `json:"seen_at"`
+	Location        string            `json:"location"`
+	Latitude        *float64          `json:"latitude,omitempty"`
+	Longitude       *float64          `json:"longitude,omitempty"`
+	PhotoURL        string            `json:"photo_url,omitempty"`
+	PhotoVariants   map[string]string `json:"photo_variants,omitempty"`
+	ReporterName    string            `json:"reporter_name,omitempty"`
+	ReporterContact string            `json:"reporter_contact,omitempty"`
+	CreatedAt       string            `json:"created_at"`
+}
+
+// Memorial DTOs
+type TributeRequest struct {
+	Message string `json:"message" binding:"required,max=1000" example:"Run free, sweet boy"`
+}
+
+type TributeItem struct {
+	ID        string `json:"id"`
+	UserID    string `json:"user_id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	AvatarURL string `json:"avatar_url"`
+	Message   string `json:"message"`
+	CreatedAt string `json:"created_at"`
+}
+
+// Pet data export DTOs
+type PetExportItem struct {
+	ID          string `json:"id"`
+	PetID       string `json:"pet_id"`
+	Status      string `json:"status"`
+	FileName    string `json:"file_name"`
+	SizeBytes   int64  `json:"size_bytes"`
+	Error       string `json:"error,omitempty"`
+	DownloadURL string `json:"download_url,omitempty"`
+	CreatedAt   string `json:"created_at"`
+	CompletedAt string `json:"completed_at,omitempty"`
+	ExpiresAt   string `json:"expires_at,omitempty"`
+}
+
+// PetExportManifest is manifest.json at the root of a pet data export
+type PetExportManifest struct {
+	ExportedAt     string                  `json:"exported_at"`
+	Pet            *PetResponse            `json:"pet"`
+	LifeEvents     []PetLifeEventItem      `json:"life_events"`
+	Comments       []CommentResponse       `json:"comments"`
+	MedicalRecords *MedicalRecordsResponse `json:"medical_records"`
+	Measurements   []MeasurementItem       `json:"measurements"`
+	Appointments   []AppointmentItem       `json:"appointments"`
+	Tributes       []TributeItem           `json:"tributes"`
+	Files          []string                `json:"files"`
+}
+
+// Bulk import DTOs
+
+// PetImportRow is one pet in an import file. CSV files use the JSON field names
+// as column headers and accept male/female for gender.
+type PetImportRow struct {
+	ExternalID string `json:"external_id" binding:"max=100"`
+	AvatarURL  string `json:"avatar_url" binding:"omitempty,url"`
+	PetCreateRequest
+}
+
+type PetImportRequest struct {
+	Format       string `form:"format" binding:"omitempty,oneof=csv json"`
+	DryRun       bool   `form:"dry_run"`
+	FetchAvatars bool   `form:"fetch_avatars"`
+}
+
+type PetImportRowResult struct {
+	Row        int           `json:"row"`
+	ExternalID string        `json:"external_id,omitempty"`
+	Status     string        `json:"status"`
+	PetID      string        `json:"pet_id,omitempty"`
+	Errors     []ErrorDetail `json:"errors,omitempty"`
+}
+
+type PetImportItem struct {
+	ID            string               `json:"id"`
+	FileName      string               `json:"file_name"`
+	Format        string               `json:"format"`
+	Status        string               `json:"status"`
+	DryRun        bool                 `json:"dry_run"`
+	FetchAvatars  bool                 `json:"fetch_avatars"`
+	TotalRows     int                  `json:"total_rows"`
+	ProcessedRows int                  `json:"processed_rows"`
+	CreatedCount  int                  `json:"created_count"`
+	ExistingCount int                  `json:"existing_count"`
+	FailedCount   int                  `json:"failed_count"`
+	Error         string               `json:"error,omitempty"`
+	Rows          []PetImportRowResult `json:"rows"`
+	CreatedAt     string               `json:"created_at"`
+	CompletedAt   string               `json:"completed_at,omitempty"`
+}
+
+// Co-owner and ownership transfer DTOs
+type PetMemberInviteRequest struct {
+	Email string `json:"email" binding:"required,email" example:"partner@example.com"`
+	Role  string `json:"role" binding:"required,oneof=owner caretaker viewer" example:"caretaker"`
+}
+
+type PetMemberRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=owner caretaker viewer" example:"viewer"`
+}
+
+type PetMemberItem struct {
+	ID        string `json:"id"`
+	UserID    string `json:"user_id,omitempty"`
+	Email     string `json:"email"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	AvatarURL string `json:"avatar_url,omitempty"`
+	Role      string `json:"role"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at"`
+}
+
+type PetInviteItem struct {
+	ID        string `json:"id"`
+	PetID     string `json:"pet_id"`
+	PetName   string `json:"pet_name"`
+	PetAvtURL string `json:"pet_avt_url"`
+	Role      string `json:"role"`
+	CreatedAt string `json:"created_at"`
+}
+
+type PetTransferRequest struct {
+	Email string `json:"email" binding:"required,email" example:"new.owner@example.com"`
+	// Role the current owner keeps after the handover; omit to leave entirely
+	PreviousOwnerRole string `json:"previous_owner_role" binding:"omitempty,oneof=owner caretaker viewer" example:"viewer"`
+}
+
+type PetTransferItem struct {
+	ID                string `json:"id"`
+	PetID             string `json:"pet_id"`
+	PetName           string `json:"pet_name,omitempty"`
+	PetAvtURL         string `json:"pet_avt_url,omitempty"`
+	FromUserID        string `json:"from_user_id"`
+	FromName          string `json:"from_name,omitempty"`
+	ToEmail           string `json:"to_email"`
+	Status            string `json:"status"`
+	PreviousOwnerRole string `json:"previous_owner_role,omitempty"`
+	CreatedAt         string `json:"created_at"`
+}
+
+// Pedigree DTOs
+
+// PetParentsRequest links a pet to its sire and dam. Send an ID to link a pet
+// in the system, or a name for an external parent; "" clears the parent.
+type PetParentsRequest struct {
+	SireID   *string `json:"sire_id"`
+	DamID    *string `json:"dam_id"`
+	SireName *string `json:"sire_name" binding:"omitempty,max=105"`
+	DamName  *string `json:"dam_name" binding:"omitempty,max=105"`
+}
+
+type PedigreeNode struct {
+	ID          string        `json:"id,omitempty"`
+	Name        string        `json:"name,omitempty"`
+	Gender      bool          `json:"gender"`
+	Type        string        `json:"type,omitempty"`
+	Breed       string        `json:"breed,omitempty"`
+	AvtURL      string        `json:"avt_url,omitempty"`
+	DateOfBirth string        `json:"date_of_birth,omitempty"`
+	External    bool          `json:"external,omitempty"`
+	Hidden      bool          `json:"hidden,omitempty"`
+	Sire        *PedigreeNode `json:"sire,omitempty"`
+	Dam         *PedigreeNode `json:"dam,omitempty"`
+}
+
+type PetRelativeItem struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Gender      bool   `json:"gender"`
+	Type        string `json:"type"`
+	Breed       string `json:"breed"`
+	AvtURL      string `json:"avt_url"`
+	DateOfBirth string `json:"date_of_birth"`
+	Relation    string `json:"relation,omitempty" example:"full"`
+}
+
+// Catalog DTOs
+type CatalogItem struct {
+	Code        string `json:"code" example:"labrador_retriever"`
+	Name        string `json:"name" example:"Labrador Retriever"`
+	SpeciesCode string `json:"species_code,omitempty" example:"dog"`
+	Matched     string `json:"matched,omitempty" example:"lab"`
+}
+
+type CatalogSpeciesRequest struct {
+	Code    string            `json:"code" binding:"required,max=50" example:"guinea_pig"`
+	Name    string            `json:"name" binding:"required,max=50" example:"Guinea Pig"`
+	Names   map[string]string `json:"names" example:"vi:Chuột lang"`
+	Aliases []string          `json:"aliases"`
+}
+
+type CatalogBreedRequest struct {
+	Code        string            `json:"code" binding:"required,max=100" example:"poodle"`
+	SpeciesCode string            `json:"species_code" binding:"required,max=50" example:"dog"`
+	Name        string            `json:"name" binding:"required,max=50" example:"Poodle"`
+	Names       map[string]string `json:"names"`
+	Aliases     []string          `json:"aliases"`
+}
+
+type CatalogTermRequest struct {
+	EntityType string `json:"entity_type" binding:"required,oneof=species breed"`
+	Code       string `json:"code" binding:"required,max=100"`
+	Locale     string `json:"locale" binding:"max=10" example:"vi"`
+	Term       string `json:"term" binding:"required,max=100" example:"Chó xù"`
+	IsAlias    bool   `json:"is_alias"`
+}
+
+// Medical record DTOs
+type VaccinationRequest struct {
+	Vaccine        string `json:"vaccine" binding:"required,max=105"`
+	BatchNumber    string `json:"batch_number" binding:"max=50"`
+	AdministeredAt string `json:"administered_at" binding:"required" example:"2024-01-15"`
+	NextDueAt      string `json:"next_due_at" example:"2025-01-15"`
+	Veterinarian   string `json:"veterinarian" binding:"max=105"`
+	Notes          string `json:"notes"`
+	AppointmentID  string `json:"appointment_id"`
+}
+
+type VaccinationItem struct {
+	ID             string `json:"id"`
+	PetID          string `json:"pet_id"`
+	AppointmentID  string `json:"appointment_id,omitempty"`
+	Vaccine        string `json:"vaccine"`
+	BatchNumber    string `json:"batch_number"`
+	AdministeredAt string `json:"administered_at"`
+	NextDueAt      string `json:"next_due_at,omitempty"`
+	Veterinarian   string `json:"veterinarian"`
+	Notes          string `json:"notes"`
+}
+
+type TreatmentRequest struct {
+	Diagnosis     string `json:"diagnosis" binding:"required,max=255"`
+	Treatment     string `json:"treatment"`
+	TreatedAt     string `json:"treated_at" binding:"required" example:"2024-01-15"`
+	Veterinarian  string `json:"veterinarian" binding:"max=105"`
+	Notes         string `json:"notes"`
+	AppointmentID string `json:"appointment_id"`
+}
+
+type TreatmentItem struct {
+	ID            string `json:"id"`
+	PetID         string `json:"pet_id"`
+	AppointmentID string `json:"appointment_id,omitempty"`
+	Diagnosis     string `json:"diagnosis"`
+	Treatment     string `json:"treatment"`
+	TreatedAt     string `json:"treated_at"`
+	Veterinarian  string `json:"veterinarian"`
+	Notes         string `json:"notes"`
+}
+
+type MedicationRequest struct {
+	Name          string `json:"name" binding:"required,max=105"`
+	Dosage        string `json:"dosage" binding:"required,max=100" example:"5 mg/kg"`
+	Frequency     string `json:"frequency" binding:"max=100" example:"twice daily"`
+	StartDate     string `json:"start_date" binding:"required" example:"2024-01-15"`
+	EndDate       string `json:"end_date" example:"2024-01-29"`
+	NextDueAt     string `json:"next_due_at" example:"2024-01-16 08:00:00"`
+	Instructions  string `json:"instructions"`
+	PrescribedBy  string `json:"prescribed_by" binding:"max=105"`
+	AppointmentID string `json:"appointment_id"`
+}
+
+type MedicationItem struct {
+	ID            string `json:"id"`
+	PetID         string `json:"pet_id"`
+	AppointmentID string `json:"appointment_id,omitempty"`
+	Name          string `json:"name"`
+	Dosage        string `json:"dosage"`
+	Frequency     string `json:"frequency"`
+	StartDate     string `json:"start_date"`
+	EndDate       string `json:"end_date,omitempty"`
+	NextDueAt     string `json:"next_due_at,omitempty"`
+	Instructions  string `json:"instructions"`
+	PrescribedBy  string `json:"prescribed_by"`
+}
+
+type MedicalRecordsResponse struct {
+	PetID        string            `json:"pet_id"`
+	Vaccinations []VaccinationItem `json:"vaccinations"`
+	Treatments   []TreatmentItem   `json:"treatments"`
+	Medications  []MedicationItem  `json:"medications"`
+}
+
+// Growth tracking DTOs
+type MeasurementRequest struct {
+	MeasuredAt         string   `json:"measured_at" example:"2024-01-15 09:30:00"`
+	WeightKg           *float64 `json:"weight_kg" binding:"omitempty,gt=0,lte=200" example:"12.5"`
+	BodyConditionScore *int     `json:"body_condition_score" binding:"omitempty,min=1,max=9" example:"5"`
+	HeightCm           *float64 `json:"height_cm" binding:"omitempty,gt=0,lte=150" example:"40"`
+	Notes              string   `json:"notes" binding:"max=255"`
+	AppointmentID      string   `json:"appointment_id"`
+}
+
+type MeasurementItem struct {
+	ID                 string   `json:"id"`
+	PetID              string   `json:"pet_id"`
+	MeasuredAt         string   `json:"measured_at"`
+	WeightKg           *float64 `json:"weight_kg,omitempty"`
+	BodyConditionScore *int     `json:"body_condition_score,omitempty"`
+	HeightCm           *float64 `json:"height_cm,omitempty"`
+	Notes              string   `json:"notes"`
+	AppointmentID      string   `json:"appointment_id,omitempty"`
+	StaffID            string   `json:"staff_id,omitempty"`
+	StaffName          string   `json:"staff_name,omitempty"`
+}
+
+type MetricStats struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+	Avg float64 `json:"avg"`
+}
+
+type MeasurementBucket struct {
+	Start         string       `json:"start" example:"2024-01-01"`
+	Count         int64        `json:"count"`
+	WeightKg      *MetricStats `json:"weight_kg,omitempty"`
+	BodyCondition *MetricStats `json:"body_condition_score,omitempty"`
+	HeightCm      *MetricStats `json:"height_cm,omitempty"`
+}
+
+type BreedReferenceRangeItem struct {
+	Type                  string   `json:"type"`
+	Breed                 string   `json:"breed"`
+	MinWeightKg           *float64 `json:"min_weight_kg,omitempty"`
+	MaxWeightKg           *float64 `json:"max_weight_kg,omitempty"`
+	MinHeightCm           *float64 `json:"min_height_cm,omitempty"`
+	MaxHeightCm           *float64 `json:"max_height_cm,omitempty"`
+	MinBodyConditionScore int      `json:"min_body_condition_score"`
+	MaxBodyConditionScore int      `json:"max_body_condition_score"`
+}
+
+type MeasurementSeriesResponse struct {
+	PetID          string                   `json:"pet_id"`
+	Bucket         string                   `json:"bucket" example:"month"`
+	Series         []MeasurementBucket      `json:"series"`
+	ReferenceRange *BreedReferenceRangeItem `json:"reference_range,omitempty"`
 }
 
 // Appointment DTOs
 type AppointmentRequest struct {
 	StartTime string `json:"start_time" binding:"required"`
 	Message   string `json:"message"`
+	PetID     string `json:"pet_id"`
 }
 
 type AppointmentResponse struct {
@@ -154,6 +704,17 @@ type AppointmentResponse struct {
 	StartTime string `json:"start_time"`
 }
 
+// AppointmentItem is a stored appointment in lists and pet exports
+type AppointmentItem struct {
+	ID         string `json:"id"`
+	Code       string `json:"code"`
+	Status     string `json:"status"`
+	StartTime  string `json:"start_time"`
+	TotalPrice int    `json:"total_price"`
+	IsOnline   bool   `json:"is_online"`
+	CreatedAt  string `json:"created_at"`
+}
+
 // Additional response DTOs for type safety
 type PetLifeEventResponse struct {
 	ID     string `json:"id"`
@@ -163,6 +724,79 @@ type PetLifeEventResponse struct {
 }
 
 type MediaResponse struct {
-	ID  string `json:"id"`
-	URL string `json:"url"`
+	ID       string  `json:"id"`
+	URL      string  `json:"url"`
+	Type     string  `json:"type,omitempty"`
+	Status   string  `json:"status"`
+	Duration float64 `json:"duration,omitempty"`
+	Width    int     `json:"width,omitempty"`
+	Height   int     `json:"height,omitempty"`
+}
+
+// MediaUploadURLRequest asks to upload one gallery file straight to storage
+type MediaUploadURLRequest struct {
+	FileName    string `json:"file_name" binding:"required,max=255" example:"beach.mp4"`
+	ContentType string `json:"content_type" binding:"required" example:"video/mp4"`
+	Size        int64  `json:"size" binding:"required,min=1" example:"52428800"`
+}
+
+// MediaUploadURLResponse is a presigned POST policy: send the fields, then the file as
+// the last form field named "file", to url
+type MediaUploadURLResponse struct {
+	UploadID  string            `json:"upload_id"`
+	URL       string            `json:"url"`
+	Method    string            `json:"method" example:"POST"`
+	Fields    map[string]string `json:"fields"`
+	MaxSize   int64             `json:"max_size"`
+	ExpiresAt string            `json:"expires_at"`
+}
+
+type MediaUploadCompleteRequest struct {
+	UploadID string `json:"upload_id" binding:"required"`
+}
+
+// Feed DTOs
+type FollowResponse struct {
+	PetID          string `json:"pet_id"`
+	Following      bool   `json:"following"`
+	Status         string `json:"status,omitempty" example:"approved"`
+	FollowersCount int64  `json:"followers_count"`
+}
+
+type FollowRequestItem struct {
+	UserID      string `json:"user_id"`
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name"`
+	AvatarURL   string `json:"avatar_url"`
+	RequestedAt string `json:"requested_at"`
+}
+
+type FeedItem struct {
+	ID         string `json:"id"`
+	Kind       string `json:"kind" example:"life_event"`
+	RefID      string `json:"ref_id"`
+	Summary    string `json:"summary"`
+	MediaURL   string `json:"media_url,omitempty"`
+	PetID      string `json:"pet_id"`
+	PetName    string `json:"pet_name"`
+	PetAvtURL  string `json:"pet_avt_url"`
+	ActorID    string `json:"actor_id"`
+	ActorName  string `json:"actor_name"`
+	OccurredAt string `json:"occurred_at"`
+}
+
+type FeedResponse struct {
+	Data       []FeedItem `json:"data"`
+	NextCursor string     `json:"next_cursor,omitempty"`
+}
+
+// Notification DTOs
+type NotificationItem struct {
+	ID        string `json:"id"`
+	Type      string `json:"type" example:"lost_pet_sighting"`
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+	Link      string `json:"link"`
+	IsRead    bool   `json:"is_read"`
+	CreatedAt string `json:"created_at"`
 }
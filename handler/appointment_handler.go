@@ -47,9 +47,14 @@ func (h *AppointmentHandler) RegisterAppointment(c *gin.Context) {
 
 	resp, err := h.appointmentService.RegisterAppointment(userInfo, req)
 	if err != nil {
-		if err.Error() == utils.PetIDNotExist {
+		switch err.Error() {
+		case utils.PetIDNotExist:
 			utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
-		} else {
+		case utils.PermissionDenied:
+			utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+		case utils.PetIsMemorial:
+			utils.BadRequestError(c, utils.ErrCodePetMemorial, utils.PetIsMemorial)
+		default:
 			utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
 		}
 		return
@@ -57,3 +62,42 @@ func (h *AppointmentHandler) RegisterAppointment(c *gin.Context) {
 
 	utils.CreatedResponse(c, resp)
 }
+
+// GetAppointments godoc
+// @Summary      Get my appointments
+// @Description  List the current user's appointments, newest first
+// @Tags         Appointments
+// @Produce      json
+// @Security     Bearer
+// @Param        page query int false "Page number (offset pagination)" default(1)
+// @Param        page_size query int false "Page size, at most 100" default(10)
+// @Param        pagination query string false "offset (default) or cursor"
+// @Param        cursor query string false "next_cursor or prev_cursor from the previous page"
+// @Param        include_total query bool false "Count total_items in cursor mode"
+// @Success      200  {object}  dto.PaginationResponse{data=[]dto.AppointmentItem}
+// @Failure      400  {object}  dto.ErrorResponse
+// @Router       /appointments [get]
+func (h *AppointmentHandler) GetAppointments(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var query dto.PageQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.appointmentService.GetAppointments(userInfo, query)
+	if err != nil {
+		if handlePageError(c, err) {
+			return
+		}
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"pet-service/storage"
+	"pet-service/utils"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// blobFieldMaxLen bounds each form field sent before the file of a signed upload
+const blobFieldMaxLen = 4 << 10
+
+// BlobHandler serves the signed links and upload policies of the local and in-memory
+// storage backends, which can't serve clients themselves
+type BlobHandler struct {
+	store storage.BlobStore
+}
+
+// NewBlobHandler creates a new stored object handler instance
+func NewBlobHandler(store storage.BlobStore) *BlobHandler {
+	return &BlobHandler{
+		store: store,
+	}
+}
+
+// ServeBlob godoc
+// @Summary      Download stored object
+// @Description  Target of the signed links handed out by the local and memory storage backends. Supports HTTP range requests.
+// @Tags         Storage
+// @Produce      octet-stream
+// @Param        key path string true "Object key"
+// @Param        expires query string true "Expiry (Unix seconds)"
+// @Param        signature query string true "Link signature"
+// @Success      200
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /blobs/{key} [get]
+func (h *BlobHandler) ServeBlob(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+	if !storage.VerifySignedGet(key, c.Query("expires"), c.Query("signature")) {
+		utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.BlobLinkInvalid)
+		return
+	}
+
+	info, err := h.store.Stat(key)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	object, err := h.store.Get(key)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	defer object.Close()
+
+	if seeker, ok := object.(io.ReadSeeker); ok {
+		c.Header("Content-Type", info.ContentType)
+		http.ServeContent(c.Writer, c.Request, "", info.LastModified, seeker)
+		return
+	}
+	c.DataFromReader(http.StatusOK, info.Size, info.ContentType, object, nil)
+}
+
+// UploadBlob godoc
+// @Summary      Upload to storage
+// @Description  Target of the upload policies handed out by the local and memory storage backends: post the policy's fields, then the file as the last field
+// @Tags         Storage
+// @Accept       multipart/form-data
+// @Param        file formData file true "File"
+// @Success      204
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      413  {object}  dto.ErrorResponse
+// @Router       /blobs [post]
+func (h *BlobHandler) UploadBlob(c *gin.Context) {
+	reader, err := c.Request.MultipartReader()
+	if err != nil {
+		utils.BadRequestError(c, utils.ErrCodeInvalidInput, err.Error())
+		return
+	}
+
+	// The file is streamed to the store, so the policy fields have to come first
+	fields := make(map[string]string)
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			utils.BadRequestError(c, utils.ErrCodeInvalidInput, utils.BlobFileMissing)
+			return
+		}
+		if err != nil {
+			utils.BadRequestError(c, utils.ErrCodeInvalidInput, err.Error())
+			return
+		}
+
+		if part.FormName() != "file" {
+			value, err := io.ReadAll(io.LimitReader(part, blobFieldMaxLen))
+			if err != nil {
+				utils.BadRequestError(c, utils.ErrCodeInvalidInput, err.Error())
+				return
+			}
+			fields[part.FormName()] = string(value)
+			continue
+		}
+
+		policy, ok := storage.VerifySignedPost(fields)
+		if !ok {
+			utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.BlobPolicyInvalid)
+			return
+		}
+
+		// Read one byte past the cap so an oversized file can be told apart
+		if err := h.store.Put(policy.Key, io.LimitReader(part, policy.MaxSize+1), -1, policy.ContentType); err != nil {
+			h.handleError(c, err)
+			return
+		}
+		info, err := h.store.Stat(policy.Key)
+		if err != nil {
+			h.handleError(c, err)
+			return
+		}
+		if info.Size == 0 || info.Size > policy.MaxSize {
+			if err := h.store.Delete(policy.Key); err != nil {
+				log.Printf("Failed to remove rejected upload %s: %v", policy.Key, err)
+			}
+			if info.Size == 0 {
+				utils.BadRequestError(c, utils.ErrCodeInvalidInput, utils.BlobFileMissing)
+			} else {
+				utils.PayloadTooLargeError(c, utils.ErrCodeUploadTooLarge, utils.UploadFileTooLarge)
+			}
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+		return
+	}
+}
+
+func (h *BlobHandler) handleError(c *gin.Context, err error) {
+	if storage.IsNotExist(err) {
+		utils.NotFoundError(c, utils.ErrCodeNotFound, utils.BlobNotExist)
+		return
+	}
+	utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+}
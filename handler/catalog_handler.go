@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/service"
+	"pet-service/utils"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CatalogHandler struct {
+	catalogService service.ICatalogService
+}
+
+// NewCatalogHandler creates a new catalog handler instance
+func NewCatalogHandler(catalogService service.ICatalogService) *CatalogHandler {
+	return &CatalogHandler{
+		catalogService: catalogService,
+	}
+}
+
+// GetSpecies godoc
+// @Summary      List species
+// @Description  List catalog species, or suggest species for a typed term (tolerates accents and typos)
+// @Tags         Catalog
+// @Accept       json
+// @Produce      json
+// @Param        q query string false "Typed term for autocomplete"
+// @Param        locale query string false "Display locale" default(en)
+// @Param        limit query int false "Max suggestions" default(10)
+// @Success      200  {object}  []dto.CatalogItem
+// @Router       /catalog/species [get]
+func (h *CatalogHandler) GetSpecies(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(utils.CatalogSuggestLimit)))
+
+	resp, err := h.catalogService.GetSpecies(c.Query("q"), c.DefaultQuery("locale", utils.CatalogDefaultLocale), limit)
+	if err != nil {
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// GetBreeds godoc
+// @Summary      List breeds
+// @Description  List the breeds of a species, or suggest breeds for a typed term
+// @Tags         Catalog
+// @Accept       json
+// @Produce      json
+// @Param        species query string false "Species code"
+// @Param        q query string false "Typed term for autocomplete"
+// @Param        locale query string false "Display locale" default(en)
+// @Param        limit query int false "Max suggestions" default(10)
+// @Success      200  {object}  []dto.CatalogItem
+// @Router       /catalog/breeds [get]
+func (h *CatalogHandler) GetBreeds(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(utils.CatalogSuggestLimit)))
+
+	resp, err := h.catalogService.GetBreeds(c.Query("species"), c.Query("q"), c.DefaultQuery("locale", utils.CatalogDefaultLocale), limit)
+	if err != nil {
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// CreateSpecies godoc
+// @Summary      Add species
+// @Description  Add a species with localized names and aliases (admin only)
+// @Tags         Catalog
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body dto.CatalogSpeciesRequest true "Species"
+// @Success      200  {object}  dto.CatalogItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Router       /catalog/species [post]
+func (h *CatalogHandler) CreateSpecies(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.CatalogSpeciesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.catalogService.CreateSpecies(userInfo, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// CreateBreed godoc
+// @Summary      Add breed
+// @Description  Add a breed under an existing species (admin only)
+// @Tags         Catalog
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body dto.CatalogBreedRequest true "Breed"
+// @Success      200  {object}  dto.CatalogItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Router       /catalog/breeds [post]
+func (h *CatalogHandler) CreateBreed(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.CatalogBreedRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.catalogService.CreateBreed(userInfo, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// AddTerm godoc
+// @Summary      Add catalog term
+// @Description  Add a localized name or alias to a species or breed (admin only)
+// @Tags         Catalog
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body dto.CatalogTermRequest true "Term"
+// @Success      200  {object}  dto.CatalogItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Router       /catalog/terms [post]
+func (h *CatalogHandler) AddTerm(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.CatalogTermRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.catalogService.AddTerm(userInfo, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+func (h *CatalogHandler) handleError(c *gin.Context, err error) {
+	switch err.Error() {
+	case utils.PermissionDenied:
+		utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+	case utils.InvalidCatalogCode:
+		utils.BadRequestError(c, utils.ErrCodeInvalidInput, utils.InvalidCatalogCode)
+	case utils.InvalidSpecies:
+		utils.BadRequestError(c, utils.ErrCodeInvalidSpecies, utils.InvalidSpecies)
+	case utils.InvalidBreed:
+		utils.BadRequestError(c, utils.ErrCodeInvalidBreed, utils.InvalidBreed)
+	case utils.CatalogCodeTaken:
+		utils.BadRequestError(c, utils.ErrCodeAlreadyExists, utils.CatalogCodeTaken)
+	default:
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+	}
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"log"
+	"mime"
+	"net/http"
+	"pet-service/middleware"
+	"pet-service/service"
+	"pet-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExportHandler struct {
+	exportService service.IExportService
+}
+
+// NewExportHandler creates a new export handler instance
+func NewExportHandler(exportService service.IExportService) *ExportHandler {
+	return &ExportHandler{
+		exportService: exportService,
+	}
+}
+
+// ExportPet godoc
+// @Summary      Export pet data
+// @Description  ZIP with manifest.json (profile, life events, comments, medical records, measurements, appointments, tributes) and every gallery file (owners only).
+// @Description  Small exports are streamed directly; large ones, or any with async=true, are built in the background and return 202 with a job to poll.
+// @Tags         Export
+// @Produce      application/zip
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Pet ID"
+// @Param        async query bool false "Always build the archive in the background"
+// @Success      200  {file}  file
+// @Success      202  {object}  dto.PetExportItem
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{id}/export [get]
+func (h *ExportHandler) ExportPet(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	archive, job, err := h.exportService.ExportPet(userInfo, c.Param("id"), c.Query("async") == "true")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	if job != nil {
+		utils.AcceptedResponse(c, job)
+		return
+	}
+	streamArchive(c, archive)
+}
+
+// GetExport godoc
+// @Summary      Get export status
+// @Description  Status of a background export requested by the current user
+// @Tags         Export
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Export ID"
+// @Success      200  {object}  dto.PetExportItem
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet-exports/{id} [get]
+func (h *ExportHandler) GetExport(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.exportService.GetExport(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// DownloadExport godoc
+// @Summary      Download export
+// @Description  Download a finished background export until it expires
+// @Tags         Export
+// @Produce      application/zip
+// @Security     Bearer
+// @Param        id path string true "Export ID"
+// @Success      200  {file}  file
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet-exports/{id}/download [get]
+func (h *ExportHandler) DownloadExport(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	archive, err := h.exportService.DownloadExport(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	streamArchive(c, archive)
+}
+
+func (h *ExportHandler) handleError(c *gin.Context, err error) {
+	switch err.Error() {
+	case utils.PetIDNotExist:
+		utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+	case utils.PermissionDenied:
+		utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+	case utils.ExportNotExist:
+		utils.NotFoundError(c, utils.ErrCodeExportNotFound, utils.ExportNotExist)
+	case utils.ExportNotReady:
+		utils.BadRequestError(c, utils.ErrCodeExportNotReady, utils.ExportNotReady)
+	default:
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+	}
+}
+
+// streamArchive sends a ZIP download, writing it straight to the response
+func streamArchive(c *gin.Context, archive *service.PetArchive) {
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": archive.FileName}))
+	c.Status(http.StatusOK)
+
+	// Headers are already sent, so a failure can only cut the download short
+	if err := archive.Write(c.Writer); err != nil {
+		log.Printf("Failed to stream %s: %v", archive.FileName, err)
+	}
+}
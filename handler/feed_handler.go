@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"pet-service/middleware"
+	"pet-service/service"
+	"pet-service/utils"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FeedHandler struct {
+	feedService service.IFeedService
+}
+
+// NewFeedHandler creates a new feed handler instance
+func NewFeedHandler(feedService service.IFeedService) *FeedHandler {
+	return &FeedHandler{
+		feedService: feedService,
+	}
+}
+
+// FollowPet godoc
+// @Summary      Follow a pet
+// @Description  Follow a pet to receive its activity in the home feed
+// @Tags         Feed
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Success      200  {object}  dto.FollowResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/follow [post]
+func (h *FeedHandler) FollowPet(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.feedService.FollowPet(userInfo, c.Param("pet_id"))
+	if err != nil {
+		if err.Error() == utils.PetIDNotExist {
+			utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+		} else {
+			utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// UnfollowPet godoc
+// @Summary      Unfollow a pet
+// @Description  Stop following a pet
+// @Tags         Feed
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Success      200  {object}  dto.FollowResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/follow [delete]
+func (h *FeedHandler) UnfollowPet(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.feedService.UnfollowPet(userInfo, c.Param("pet_id"))
+	if err != nil {
+		if err.Error() == utils.PetIDNotExist {
+			utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+		} else {
+			utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// GetFeed godoc
+// @Summary      Get home feed
+// @Description  Get life events, gallery uploads and comments from followed pets, newest first
+// @Tags         Feed
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param        limit query int false "Page size" default(20)
+// @Success      200  {object}  dto.FeedResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Router       /feed [get]
+func (h *FeedHandler) GetFeed(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(utils.FeedDefaultLimit)))
+
+	resp, err := h.feedService.GetFeed(userInfo, c.Query("cursor"), limit)
+	if err != nil {
+		if err.Error() == utils.InvalidCursor {
+			utils.BadRequestError(c, utils.ErrCodeInvalidCursor, utils.InvalidCursor)
+		} else {
+			utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// GetFollowRequests godoc
+// @Summary      Get pending follow requests
+// @Description  List users waiting for approval to follow a followers-only pet (owner only)
+// @Tags         Feed
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Pet ID"
+// @Success      200  {object}  []dto.FollowRequestItem
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{id}/follow-requests [get]
+func (h *FeedHandler) GetFollowRequests(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.feedService.GetFollowRequests(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleFollowError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// ApproveFollowRequest godoc
+// @Summary      Approve follow request
+// @Description  Let a user follow a followers-only pet (owner only)
+// @Tags         Feed
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        user_id path string true "Follower user ID"
+// @Success      200  {object}  dto.FollowResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/follow-requests/{user_id}/approve [post]
+func (h *FeedHandler) ApproveFollowRequest(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.feedService.ApproveFollowRequest(userInfo, c.Param("pet_id"), c.Param("user_id"))
+	if err != nil {
+		h.handleFollowError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// RejectFollowRequest godoc
+// @Summary      Reject follow request
+// @Description  Decline a pending follow request (owner only)
+// @Tags         Feed
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        user_id path string true "Follower user ID"
+// @Success      200  {object}  dto.MessageResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/follow-requests/{user_id} [delete]
+func (h *FeedHandler) RejectFollowRequest(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.feedService.RejectFollowRequest(userInfo, c.Param("pet_id"), c.Param("user_id"))
+	if err != nil {
+		h.handleFollowError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+func (h *FeedHandler) handleFollowError(c *gin.Context, err error) {
+	switch err.Error() {
+	case utils.PetIDNotExist:
+		utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+	case utils.FollowRequestNotExist:
+		utils.NotFoundError(c, utils.ErrCodeFollowRequestNotFound, utils.FollowRequestNotExist)
+	case utils.PermissionDenied:
+		utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+	default:
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+	}
+}
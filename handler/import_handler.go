@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/service"
+	"pet-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ImportHandler struct {
+	importService service.IImportService
+}
+
+// NewImportHandler creates a new import handler instance
+func NewImportHandler(importService service.IImportService) *ImportHandler {
+	return &ImportHandler{
+		importService: importService,
+	}
+}
+
+// StartImport godoc
+// @Summary      Bulk import pets
+// @Description  Import pets from a CSV or JSON file into the current user's account. Rows are validated like POST /pet; a row whose external_id matches one of the user's pets is skipped, so re-running an import is safe.
+// @Description  The import runs in the background; poll GET /pet-imports/{id} for progress and the per-row report. With dry_run=true nothing is written.
+// @Tags         Import
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     Bearer
+// @Param        file formData file true "CSV or JSON file"
+// @Param        format formData string false "csv or json (default: from the file name)"
+// @Param        dry_run formData bool false "Validate only"
+// @Param        fetch_avatars formData bool false "Download avatar_url images"
+// @Success      202  {object}  dto.PetImportItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Router       /pet-imports [post]
+func (h *ImportHandler) StartImport(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.PetImportRequest
+	if err := c.ShouldBind(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		utils.BadRequestError(c, utils.ErrCodeInvalidInput, "File is required")
+		return
+	}
+	if fileHeader.Size > utils.ImportMaxFileSize {
+		utils.BadRequestError(c, utils.ErrCodeInvalidImportFile, utils.ImportFileTooLarge)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		utils.BadRequestError(c, utils.ErrCodeInvalidInput, "Cannot open file")
+		return
+	}
+	defer file.Close()
+
+	resp, err := h.importService.StartImport(userInfo, service.UploadFile{
+		Reader:      file,
+		Name:        fileHeader.Filename,
+		ContentType: fileHeader.Header.Get("Content-Type"),
+		Size:        fileHeader.Size,
+	}, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.AcceptedResponse(c, resp)
+}
+
+// GetImport godoc
+// @Summary      Get import progress
+// @Description  Progress, counts and the per-row report of an import started by the current user
+// @Tags         Import
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Import ID"
+// @Success      200  {object}  dto.PetImportItem
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet-imports/{id} [get]
+func (h *ImportHandler) GetImport(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.importService.GetImport(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+func (h *ImportHandler) handleError(c *gin.Context, err error) {
+	switch err.Error() {
+	case utils.ImportNotExist:
+		utils.NotFoundError(c, utils.ErrCodeImportNotFound, utils.ImportNotExist)
+	case utils.ImportFormatInvalid, utils.ImportFileInvalid, utils.ImportFileEmpty,
+		utils.ImportTooManyRows, utils.ImportMissingColumn:
+		utils.BadRequestError(c, utils.ErrCodeInvalidImportFile, err.Error())
+	default:
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+	}
+}
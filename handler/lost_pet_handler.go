@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"mime/multipart"
+	"pet-service/config"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/service"
+	"pet-service/utils"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LostPetHandler struct {
+	lostPetService service.ILostPetService
+}
+
+// NewLostPetHandler creates a new lost & found handler instance
+func NewLostPetHandler(lostPetService service.ILostPetService) *LostPetHandler {
+	return &LostPetHandler{
+		lostPetService: lostPetService,
+	}
+}
+
+// UpdateLostStatus godoc
+// @Summary      Mark pet lost or found
+// @Description  Flag a pet as lost with its last known location, or clear the flag once found (owner only)
+// @Tags         Lost & Found
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.PetLostRequest true "Lost status"
+// @Success      200  {object}  dto.LostPetItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/lost [patch]
+func (h *LostPetHandler) UpdateLostStatus(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.PetLostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.lostPetService.UpdateLostStatus(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// GetLostPets godoc
+// @Summary      Get lost pets
+// @Description  Public listing of pets currently marked lost, filterable by type, area or distance; no authentication required
+// @Tags         Lost & Found
+// @Accept       json
+// @Produce      json
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Param        type query string false "Pet type"
+// @Param        area query string false "Text match on the last seen location"
+// @Param        lat query number false "Latitude to search around"
+// @Param        lng query number false "Longitude to search around"
+// @Param        radius_km query number false "Search radius in kilometres" default(10)
+// @Success      200  {object}  dto.PaginationResponse
+// @Router       /lost-pets [get]
+func (h *LostPetHandler) GetLostPets(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+
+	resp, err := h.lostPetService.GetLostPets(c.Query("type"), c.Query("area"),
+		queryFloat(c, "lat"), queryFloat(c, "lng"), queryFloat(c, "radius_km"), page, pageSize)
+	if err != nil {
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// ReportSighting godoc
+// @Summary      Report a lost pet sighting
+// @Description  Anyone can report where they saw a lost pet, optionally with a photo; the owner is notified
+// @Tags         Lost & Found
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        pet_id path string true "Pet ID"
+// @Param        note formData string false "What you saw"
+// @Param        seen_at formData string false "When (YYYY-MM-DD HH:MM:SS)"
+// @Param        location formData string false "Where"
+// @Param        latitude formData number false "Latitude"
+// @Param        longitude formData number false "Longitude"
+// @Param        reporter_name formData string false "Your name"
+// @Param        reporter_contact formData string false "How the owner can reach you"
+// @Param        photo formData file false "Photo (JPEG, PNG, GIF or WebP)"
+// @Success      201  {object}  dto.SightingResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /lost-pets/{pet_id}/sightings [post]
+func (h *LostPetHandler) ReportSighting(c *gin.Context) {
+	limitUploadBody(c, 1, config.AppConfig.UploadMaxFileSize)
+	var req dto.SightingRequest
+	if err := c.ShouldBind(&req); err != nil {
+		if !handleUploadError(c, err) {
+			utils.ValidationError(c, err)
+		}
+		return
+	}
+
+	// Signed-in reporters are linked to the sighting; anonymous reports are allowed
+	var reporter *middleware.UserInfo
+	if userInfo, exists := middleware.GetCurrentUser(c); exists {
+		reporter = &userInfo
+	}
+
+	var photo *service.UploadFile
+	if fileHeader, err := c.FormFile("photo"); err == nil {
+		uploads, closeUploads, err := openUploads([]*multipart.FileHeader{fileHeader}, config.AppConfig.UploadMaxFileSize)
+		if err != nil {
+			handleUploadError(c, err)
+			return
+		}
+		defer closeUploads()
+		photo = &uploads[0]
+	}
+
+	resp, err := h.lostPetService.ReportSighting(reporter, c.Param("pet_id"), req, photo)
+	if err != nil {
+		if handleUploadError(c, err) {
+			return
+		}
+		h.handleError(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, resp)
+}
+
+// GetSightings godoc
+// @Summary      Get pet sightings
+// @Description  Sightings reported for a lost pet, including reporter contact details (owner only)
+// @Tags         Lost & Found
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Pet ID"
+// @Success      200  {object}  []dto.SightingResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{id}/sightings [get]
+func (h *LostPetHandler) GetSightings(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.lostPetService.GetSightings(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+func (h *LostPetHandler) handleError(c *gin.Context, err error) {
+	switch err.Error() {
+	case utils.PetIDNotExist:
+		utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+	case utils.PetIsNotLost:
+		utils.BadRequestError(c, utils.ErrCodePetNotLost, utils.PetIsNotLost)
+	case utils.PetIsMemorial:
+		utils.BadRequestError(c, utils.ErrCodePetMemorial, utils.PetIsMemorial)
+	case utils.PermissionDenied:
+		utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+	default:
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+	}
+}
+
+// queryFloat parses an optional float query parameter; missing or invalid values yield nil
+func queryFloat(c *gin.Context, key string) *float64 {
+	value, err := strconv.ParseFloat(c.Query(key), 64)
+	if err != nil {
+		return nil
+	}
+	return &value
+}
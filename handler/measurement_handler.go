@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/service"
+	"pet-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MeasurementHandler struct {
+	measurementService service.IMeasurementService
+}
+
+// NewMeasurementHandler creates a new growth tracking handler instance
+func NewMeasurementHandler(measurementService service.IMeasurementService) *MeasurementHandler {
+	return &MeasurementHandler{
+		measurementService: measurementService,
+	}
+}
+
+// CreateMeasurement godoc
+// @Summary      Record pet measurement
+// @Description  Record weight, body condition score (1-9) and/or height. Clinic staff need edit_pet; readings with an appointment_id are staff-only and attributed to the staff member
+// @Tags         Growth
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.MeasurementRequest true "Measurement"
+// @Success      201  {object}  dto.MeasurementItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/measurements [post]
+func (h *MeasurementHandler) CreateMeasurement(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.MeasurementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.measurementService.CreateMeasurement(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, resp)
+}
+
+// DeleteMeasurement godoc
+// @Summary      Delete pet measurement
+// @Description  Remove a reading entered by mistake (owner, or clinic staff with edit_pet)
+// @Tags         Growth
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        measurement_id path string true "Measurement ID"
+// @Success      200  {object}  dto.MessageResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/measurements/{measurement_id} [delete]
+func (h *MeasurementHandler) DeleteMeasurement(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.measurementService.DeleteMeasurement(userInfo, c.Param("pet_id"), c.Param("measurement_id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// GetMeasurements godoc
+// @Summary      Get pet measurements
+// @Description  Raw readings, newest first (owner or clinic staff)
+// @Tags         Growth
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Pet ID"
+// @Param        from query string false "From date (YYYY-MM-DD)"
+// @Param        to query string false "To date, inclusive (YYYY-MM-DD)"
+// @Success      200  {object}  []dto.MeasurementItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{id}/measurements [get]
+func (h *MeasurementHandler) GetMeasurements(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.measurementService.GetMeasurements(userInfo, c.Param("id"), c.Query("from"), c.Query("to"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// GetMeasurementSeries godoc
+// @Summary      Get pet growth chart
+// @Description  Weekly or monthly min/max/avg of weight, body condition and height, with the breed's typical range when known (owner or clinic staff)
+// @Tags         Growth
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Pet ID"
+// @Param        bucket query string false "week or month" default(month)
+// @Param        from query string false "From date (YYYY-MM-DD)"
+// @Param        to query string false "To date, inclusive (YYYY-MM-DD)"
+// @Success      200  {object}  dto.MeasurementSeriesResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{id}/measurements/series [get]
+func (h *MeasurementHandler) GetMeasurementSeries(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.measurementService.GetMeasurementSeries(userInfo, c.Param("id"), c.Query("bucket"), c.Query("from"), c.Query("to"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+func (h *MeasurementHandler) handleError(c *gin.Context, err error) {
+	switch err.Error() {
+	case utils.PetIDNotExist:
+		utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+	case utils.MeasurementNotExist:
+		utils.NotFoundError(c, utils.ErrCodeMeasurementNotFound, utils.MeasurementNotExist)
+	case utils.AppointmentNotExist:
+		utils.NotFoundError(c, utils.ErrCodeAppointmentNotFound, utils.AppointmentNotExist)
+	case utils.MeasurementEmpty, utils.InvalidDate, utils.InvalidBucket:
+		utils.BadRequestError(c, utils.ErrCodeInvalidInput, err.Error())
+	case utils.PermissionDenied:
+		utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+	default:
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+	}
+}
@@ -0,0 +1,345 @@
+package handler
+
+import (
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/service"
+	"pet-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MediaHandler struct {
+	mediaService service.IMediaService
+}
+
+// NewMediaHandler creates a new gallery management handler instance
+func NewMediaHandler(mediaService service.IMediaService) *MediaHandler {
+	return &MediaHandler{
+		mediaService: mediaService,
+	}
+}
+
+// DeleteMedia godoc
+// @Summary      Delete gallery item
+// @Description  Remove a photo or video and its stored files (owners, or the caretaker who uploaded it). Items still processing can't be deleted until BACKGROUND_JOB_TIMEOUT_MINUTES has passed.
+// @Tags         Gallery
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Media ID"
+// @Success      200  {object}  dto.MessageResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      409  {object}  dto.ErrorResponse
+// @Router       /media/{id} [delete]
+func (h *MediaHandler) DeleteMedia(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.mediaService.DeleteMedia(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// UpdateMedia godoc
+// @Summary      Edit gallery item
+// @Description  Set a photo or video's caption or album; an empty album_id removes it from its album (owners and caretakers)
+// @Tags         Gallery
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Media ID"
+// @Param        request body dto.MediaUpdateRequest true "Caption and album"
+// @Success      200  {object}  dto.MediaItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /media/{id} [patch]
+func (h *MediaHandler) UpdateMedia(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.MediaUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.mediaService.UpdateMedia(userInfo, c.Param("id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// ReorderMedias godoc
+// @Summary      Reorder gallery
+// @Description  Set the gallery order: the listed items come first, in the order given, followed by the rest (owners and caretakers)
+// @Tags         Gallery
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.MediaOrderRequest true "Media IDs in display order"
+// @Success      200  {object}  dto.MessageResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/media/order [patch]
+func (h *MediaHandler) ReorderMedias(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.MediaOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.mediaService.ReorderMedias(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// SetCover godoc
+// @Summary      Set pet cover
+// @Description  Pick the gallery item shown as the pet's cover; an empty media_id clears it (owners only)
+// @Tags         Gallery
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.PetCoverRequest true "Cover"
+// @Success      200  {object}  dto.MessageResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      409  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/cover [patch]
+func (h *MediaHandler) SetCover(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.PetCoverRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.mediaService.SetCover(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// SetAvatar godoc
+// @Summary      Set avatar from gallery
+// @Description  Make a gallery photo, or a video's poster frame, the pet's avatar. The avatar keeps its own copy, so deleting the gallery item doesn't affect it (owners only).
+// @Tags         Gallery
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.PetAvatarRequest true "Gallery item"
+// @Success      200  {object}  dto.PetResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      409  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/avatar [patch]
+func (h *MediaHandler) SetAvatar(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.PetAvatarRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.mediaService.SetAvatar(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// CreateAlbum godoc
+// @Summary      Create album
+// @Description  Add a named album to a pet's gallery (owners and caretakers)
+// @Tags         Gallery
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.MediaAlbumRequest true "Album"
+// @Success      201  {object}  dto.MediaAlbumItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/albums [post]
+func (h *MediaHandler) CreateAlbum(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.MediaAlbumRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.mediaService.CreateAlbum(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, resp)
+}
+
+// GetAlbums godoc
+// @Summary      Get albums
+// @Description  A pet's albums, oldest first, with their cover and item count (anyone who can view the pet)
+// @Tags         Gallery
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Pet ID"
+// @Success      200  {object}  []dto.MediaAlbumItem
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{id}/albums [get]
+func (h *MediaHandler) GetAlbums(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.mediaService.GetAlbums(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// UpdateAlbum godoc
+// @Summary      Edit album
+// @Description  Rename an album, edit its description or pick its cover; an empty cover_media_id clears the cover (owners and caretakers)
+// @Tags         Gallery
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        album_id path string true "Album ID"
+// @Param        request body dto.MediaAlbumUpdateRequest true "Album changes"
+// @Success      200  {object}  dto.MediaAlbumItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      409  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/albums/{album_id} [patch]
+func (h *MediaHandler) UpdateAlbum(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.MediaAlbumUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.mediaService.UpdateAlbum(userInfo, c.Param("pet_id"), c.Param("album_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// DeleteAlbum godoc
+// @Summary      Delete album
+// @Description  Remove an album; its photos and videos stay in the gallery (owners and caretakers)
+// @Tags         Gallery
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        album_id path string true "Album ID"
+// @Success      200  {object}  dto.MessageResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/albums/{album_id} [delete]
+func (h *MediaHandler) DeleteAlbum(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.mediaService.DeleteAlbum(userInfo, c.Param("pet_id"), c.Param("album_id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+func (h *MediaHandler) handleError(c *gin.Context, err error) {
+	switch err.Error() {
+	case utils.PetIDNotExist:
+		utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+	case utils.PermissionDenied:
+		utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+	case utils.MediaNotExist:
+		utils.NotFoundError(c, utils.ErrCodeMediaNotFound, utils.MediaNotExist)
+	case utils.AlbumNotExist:
+		utils.NotFoundError(c, utils.ErrCodeAlbumNotFound, utils.AlbumNotExist)
+	case utils.MediaProcessing:
+		utils.ConflictError(c, utils.ErrCodeMediaProcessing, utils.MediaProcessing)
+	case utils.MediaNoImage:
+		utils.BadRequestError(c, utils.ErrCodeInvalidInput, utils.MediaNoImage)
+	default:
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+	}
+}
@@ -0,0 +1,372 @@
+package handler
+
+import (
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/service"
+	"pet-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MedicalHandler struct {
+	medicalService service.IMedicalService
+}
+
+// NewMedicalHandler creates a new medical records handler instance
+func NewMedicalHandler(medicalService service.IMedicalService) *MedicalHandler {
+	return &MedicalHandler{
+		medicalService: medicalService,
+	}
+}
+
+// GetMedicalRecords godoc
+// @Summary      Get pet medical records
+// @Description  Vaccinations, treatments and medications recorded for a pet (owner or clinic staff)
+// @Tags         Medical Records
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Pet ID"
+// @Success      200  {object}  dto.MedicalRecordsResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{id}/medical-records [get]
+func (h *MedicalHandler) GetMedicalRecords(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.medicalService.GetMedicalRecords(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// CreateVaccination godoc
+// @Summary      Add vaccination record
+// @Description  Record a vaccination for a pet, optionally linked to an appointment (clinic staff)
+// @Tags         Medical Records
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.VaccinationRequest true "Vaccination data"
+// @Success      201  {object}  dto.VaccinationItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/vaccinations [post]
+func (h *MedicalHandler) CreateVaccination(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.VaccinationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.medicalService.CreateVaccination(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, resp)
+}
+
+// UpdateVaccination godoc
+// @Summary      Update vaccination record
+// @Description  Replace the details of a vaccination record (clinic staff)
+// @Tags         Medical Records
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        record_id path string true "Vaccination ID"
+// @Param        request body dto.VaccinationRequest true "Vaccination data"
+// @Success      200  {object}  dto.VaccinationItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/vaccinations/{record_id} [patch]
+func (h *MedicalHandler) UpdateVaccination(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.VaccinationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.medicalService.UpdateVaccination(userInfo, c.Param("pet_id"), c.Param("record_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// DeleteVaccination godoc
+// @Summary      Delete vaccination record
+// @Description  Remove a vaccination record entered by mistake (clinic staff)
+// @Tags         Medical Records
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        record_id path string true "Vaccination ID"
+// @Success      200  {object}  dto.MessageResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/vaccinations/{record_id} [delete]
+func (h *MedicalHandler) DeleteVaccination(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.medicalService.DeleteVaccination(userInfo, c.Param("pet_id"), c.Param("record_id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// CreateTreatment godoc
+// @Summary      Add treatment record
+// @Description  Record a treatment for a pet, optionally linked to an appointment (clinic staff)
+// @Tags         Medical Records
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.TreatmentRequest true "Treatment data"
+// @Success      201  {object}  dto.TreatmentItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/treatments [post]
+func (h *MedicalHandler) CreateTreatment(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.TreatmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.medicalService.CreateTreatment(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, resp)
+}
+
+// UpdateTreatment godoc
+// @Summary      Update treatment record
+// @Description  Replace the details of a treatment record (clinic staff)
+// @Tags         Medical Records
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        record_id path string true "Treatment ID"
+// @Param        request body dto.TreatmentRequest true "Treatment data"
+// @Success      200  {object}  dto.TreatmentItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/treatments/{record_id} [patch]
+func (h *MedicalHandler) UpdateTreatment(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.TreatmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.medicalService.UpdateTreatment(userInfo, c.Param("pet_id"), c.Param("record_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// DeleteTreatment godoc
+// @Summary      Delete treatment record
+// @Description  Remove a treatment record entered by mistake (clinic staff)
+// @Tags         Medical Records
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        record_id path string true "Treatment ID"
+// @Success      200  {object}  dto.MessageResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/treatments/{record_id} [delete]
+func (h *MedicalHandler) DeleteTreatment(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.medicalService.DeleteTreatment(userInfo, c.Param("pet_id"), c.Param("record_id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// CreateMedication godoc
+// @Summary      Add medication record
+// @Description  Record a medication for a pet, optionally linked to an appointment (clinic staff)
+// @Tags         Medical Records
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.MedicationRequest true "Medication data"
+// @Success      201  {object}  dto.MedicationItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/medications [post]
+func (h *MedicalHandler) CreateMedication(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.MedicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.medicalService.CreateMedication(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, resp)
+}
+
+// UpdateMedication godoc
+// @Summary      Update medication record
+// @Description  Replace the details of a medication record (clinic staff)
+// @Tags         Medical Records
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        record_id path string true "Medication ID"
+// @Param        request body dto.MedicationRequest true "Medication data"
+// @Success      200  {object}  dto.MedicationItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/medications/{record_id} [patch]
+func (h *MedicalHandler) UpdateMedication(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.MedicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.medicalService.UpdateMedication(userInfo, c.Param("pet_id"), c.Param("record_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// DeleteMedication godoc
+// @Summary      Delete medication record
+// @Description  Remove a medication record entered by mistake (clinic staff)
+// @Tags         Medical Records
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        record_id path string true "Medication ID"
+// @Success      200  {object}  dto.MessageResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/medications/{record_id} [delete]
+func (h *MedicalHandler) DeleteMedication(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.medicalService.DeleteMedication(userInfo, c.Param("pet_id"), c.Param("record_id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+func (h *MedicalHandler) handleError(c *gin.Context, err error) {
+	switch err.Error() {
+	case utils.PetIDNotExist:
+		utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+	case utils.MedicalRecordNotExist:
+		utils.NotFoundError(c, utils.ErrCodeMedicalRecordNotFound, utils.MedicalRecordNotExist)
+	case utils.AppointmentNotExist:
+		utils.NotFoundError(c, utils.ErrCodeAppointmentNotFound, utils.AppointmentNotExist)
+	case utils.InvalidDate:
+		utils.BadRequestError(c, utils.ErrCodeInvalidInput, utils.InvalidDate)
+	case utils.PermissionDenied:
+		utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+	default:
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+	}
+}
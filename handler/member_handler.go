@@ -0,0 +1,393 @@
+package handler
+
+import (
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/service"
+	"pet-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MemberHandler struct {
+	memberService service.IMemberService
+}
+
+// NewMemberHandler creates a new co-owner/transfer handler instance
+func NewMemberHandler(memberService service.IMemberService) *MemberHandler {
+	return &MemberHandler{
+		memberService: memberService,
+	}
+}
+
+// InviteMember godoc
+// @Summary      Invite co-owner
+// @Description  Invite someone by email to share a pet as owner, caretaker or viewer (owners only)
+// @Tags         Co-owners
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.PetMemberInviteRequest true "Invite"
+// @Success      201  {object}  dto.PetMemberItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/members [post]
+func (h *MemberHandler) InviteMember(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.PetMemberInviteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.memberService.InviteMember(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, resp)
+}
+
+// GetMembers godoc
+// @Summary      Get co-owners
+// @Description  Co-owners of a pet with their roles; owners also see pending invites (owners and caretakers)
+// @Tags         Co-owners
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Pet ID"
+// @Success      200  {object}  []dto.PetMemberItem
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{id}/members [get]
+func (h *MemberHandler) GetMembers(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.memberService.GetMembers(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// UpdateMemberRole godoc
+// @Summary      Change co-owner role
+// @Description  Change the role of a co-owner or pending invite (owners only)
+// @Tags         Co-owners
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        member_id path string true "Member ID"
+// @Param        request body dto.PetMemberRoleRequest true "Role"
+// @Success      200  {object}  dto.PetMemberItem
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/members/{member_id} [patch]
+func (h *MemberHandler) UpdateMemberRole(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.PetMemberRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.memberService.UpdateMemberRole(userInfo, c.Param("pet_id"), c.Param("member_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// RemoveMember godoc
+// @Summary      Remove co-owner
+// @Description  Revoke a co-owner or pending invite (owners), or leave a pet you co-own
+// @Tags         Co-owners
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        member_id path string true "Member ID"
+// @Success      200  {object}  dto.MessageResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/members/{member_id} [delete]
+func (h *MemberHandler) RemoveMember(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.memberService.RemoveMember(userInfo, c.Param("pet_id"), c.Param("member_id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// GetInvites godoc
+// @Summary      Get my co-owner invites
+// @Description  Pending co-owner invites addressed to the current user's email
+// @Tags         Co-owners
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Success      200  {object}  []dto.PetInviteItem
+// @Router       /pet-invites [get]
+func (h *MemberHandler) GetInvites(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.memberService.GetInvites(userInfo)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// AcceptInvite godoc
+// @Summary      Accept co-owner invite
+// @Description  Accept a pending co-owner invite addressed to the current user
+// @Tags         Co-owners
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Invite ID"
+// @Success      200  {object}  dto.PetMemberItem
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet-invites/{id}/accept [post]
+func (h *MemberHandler) AcceptInvite(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.memberService.AcceptInvite(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// DeclineInvite godoc
+// @Summary      Decline co-owner invite
+// @Description  Decline a pending co-owner invite addressed to the current user
+// @Tags         Co-owners
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Invite ID"
+// @Success      200  {object}  dto.MessageResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet-invites/{id} [delete]
+func (h *MemberHandler) DeclineInvite(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.memberService.DeclineInvite(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// TransferOwnership godoc
+// @Summary      Transfer pet ownership
+// @Description  Offer the pet to someone by email; ownership moves with its full history once they accept (primary owner only)
+// @Tags         Co-owners
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.PetTransferRequest true "Transfer"
+// @Success      201  {object}  dto.PetTransferItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/transfer [post]
+func (h *MemberHandler) TransferOwnership(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.PetTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.memberService.TransferOwnership(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, resp)
+}
+
+// CancelTransfer godoc
+// @Summary      Cancel ownership transfer
+// @Description  Withdraw the pet's pending ownership transfer
+// @Tags         Co-owners
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Success      200  {object}  dto.MessageResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/transfer [delete]
+func (h *MemberHandler) CancelTransfer(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.memberService.CancelTransfer(userInfo, c.Param("pet_id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// GetTransfers godoc
+// @Summary      Get incoming transfers
+// @Description  Pending ownership transfers addressed to the current user's email
+// @Tags         Co-owners
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Success      200  {object}  []dto.PetTransferItem
+// @Router       /pet-transfers [get]
+func (h *MemberHandler) GetTransfers(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.memberService.GetTransfers(userInfo)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// AcceptTransfer godoc
+// @Summary      Accept ownership transfer
+// @Description  Become the pet's owner; life events, media and medical records come with it
+// @Tags         Co-owners
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Transfer ID"
+// @Success      200  {object}  dto.PetTransferItem
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet-transfers/{id}/accept [post]
+func (h *MemberHandler) AcceptTransfer(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.memberService.AcceptTransfer(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// DeclineTransfer godoc
+// @Summary      Decline ownership transfer
+// @Description  Decline a pending ownership transfer addressed to the current user
+// @Tags         Co-owners
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Transfer ID"
+// @Success      200  {object}  dto.MessageResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet-transfers/{id} [delete]
+func (h *MemberHandler) DeclineTransfer(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.memberService.DeclineTransfer(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+func (h *MemberHandler) handleError(c *gin.Context, err error) {
+	switch err.Error() {
+	case utils.PetIDNotExist:
+		utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+	case utils.PermissionDenied:
+		utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+	case utils.PetInviteNotExist:
+		utils.NotFoundError(c, utils.ErrCodeInviteNotFound, utils.PetInviteNotExist)
+	case utils.PetTransferNotExist:
+		utils.NotFoundError(c, utils.ErrCodeTransferNotFound, utils.PetTransferNotExist)
+	case utils.UserIsNotExist:
+		utils.NotFoundError(c, utils.ErrCodeUserNotFound, utils.UserIsNotExist)
+	case utils.PetMemberExists, utils.PetTransferPending:
+		utils.BadRequestError(c, utils.ErrCodeAlreadyExists, err.Error())
+	case utils.CannotInviteSelf:
+		utils.BadRequestError(c, utils.ErrCodeInvalidInput, utils.CannotInviteSelf)
+	default:
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+	}
+}
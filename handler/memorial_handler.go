@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/service"
+	"pet-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MemorialHandler struct {
+	memorialService service.IMemorialService
+}
+
+// NewMemorialHandler creates a new memorial handler instance
+func NewMemorialHandler(memorialService service.IMemorialService) *MemorialHandler {
+	return &MemorialHandler{
+		memorialService: memorialService,
+	}
+}
+
+// CreateTribute godoc
+// @Summary      Leave a tribute
+// @Description  Post a message on a memorial profile (anyone who can see the pet)
+// @Tags         Memorial
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.TributeRequest true "Tribute"
+// @Success      201  {object}  dto.TributeItem
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/tributes [post]
+func (h *MemorialHandler) CreateTribute(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.TributeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.memorialService.CreateTribute(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, resp)
+}
+
+// GetTributes godoc
+// @Summary      Get tributes
+// @Description  Tribute wall of a memorial profile, newest first
+// @Tags         Memorial
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Pet ID"
+// @Success      200  {object}  []dto.TributeItem
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{id}/tributes [get]
+func (h *MemorialHandler) GetTributes(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.memorialService.GetTributes(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// DeleteTribute godoc
+// @Summary      Delete a tribute
+// @Description  Remove a tribute (its author or the pet's owners)
+// @Tags         Memorial
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        tribute_id path string true "Tribute ID"
+// @Success      200  {object}  dto.MessageResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/tributes/{tribute_id} [delete]
+func (h *MemorialHandler) DeleteTribute(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.memorialService.DeleteTribute(userInfo, c.Param("pet_id"), c.Param("tribute_id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// GetArchive godoc
+// @Summary      Download pet archive
+// @Description  ZIP with the pet's profile, life events, tributes and all photos (owners only)
+// @Tags         Memorial
+// @Produce      application/zip
+// @Security     Bearer
+// @Param        id path string true "Pet ID"
+// @Success      200  {file}  file
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{id}/archive [get]
+func (h *MemorialHandler) GetArchive(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	archive, err := h.memorialService.GetArchive(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	streamArchive(c, archive)
+}
+
+func (h *MemorialHandler) handleError(c *gin.Context, err error) {
+	switch err.Error() {
+	case utils.PetIDNotExist:
+		utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+	case utils.PermissionDenied:
+		utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+	case utils.TributeNotExist:
+		utils.NotFoundError(c, utils.ErrCodeTributeNotFound, utils.TributeNotExist)
+	case utils.PetNotMemorial:
+		utils.BadRequestError(c, utils.ErrCodePetMemorial, utils.PetNotMemorial)
+	default:
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+	}
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"pet-service/middleware"
+	"pet-service/service"
+	"pet-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationHandler struct {
+	notificationService service.INotificationService
+}
+
+// NewNotificationHandler creates a new notification handler instance
+func NewNotificationHandler(notificationService service.INotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+// GetNotifications godoc
+// @Summary      Get notifications
+// @Description  Get the current user's most recent in-app notifications
+// @Tags         Notifications
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        unread query bool false "Only unread notifications"
+// @Success      200  {object}  []dto.NotificationItem
+// @Router       /notifications [get]
+func (h *NotificationHandler) GetNotifications(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.notificationService.GetNotifications(userInfo, c.Query("unread") == "true")
+	if err != nil {
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// MarkRead godoc
+// @Summary      Mark notification read
+// @Description  Mark one of the current user's notifications as read
+// @Tags         Notifications
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Notification ID"
+// @Success      200  {object}  dto.NotificationItem
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /notifications/{id}/read [patch]
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.notificationService.MarkRead(userInfo, c.Param("id"))
+	if err != nil {
+		if err.Error() == utils.NotificationNotExist {
+			utils.NotFoundError(c, utils.ErrCodeNotificationNotFound, utils.NotificationNotExist)
+		} else {
+			utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"pet-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handlePageError answers the bad-request errors shared by paginated lists
+func handlePageError(c *gin.Context, err error) bool {
+	switch err.Error() {
+	case utils.InvalidCursor:
+		utils.BadRequestError(c, utils.ErrCodeInvalidCursor, utils.InvalidCursor)
+	case utils.CursorSortUnsupported:
+		utils.BadRequestError(c, utils.ErrCodeInvalidInput, utils.CursorSortUnsupported)
+	default:
+		return false
+	}
+	return true
+}
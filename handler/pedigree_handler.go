@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/service"
+	"pet-service/utils"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PedigreeHandler struct {
+	pedigreeService service.IPedigreeService
+}
+
+// NewPedigreeHandler creates a new pedigree handler instance
+func NewPedigreeHandler(pedigreeService service.IPedigreeService) *PedigreeHandler {
+	return &PedigreeHandler{
+		pedigreeService: pedigreeService,
+	}
+}
+
+// SetParents godoc
+// @Summary      Set pet parents
+// @Description  Link the sire and dam to pets in the system (any owner's, if visible) or record external name-only parents (owner only)
+// @Tags         Pedigree
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.PetParentsRequest true "Parents"
+// @Success      200  {object}  dto.PedigreeNode
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/parents [patch]
+func (h *PedigreeHandler) SetParents(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.PetParentsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.pedigreeService.SetParents(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// GetPedigree godoc
+// @Summary      Get pedigree
+// @Description  Ancestry tree of a pet; ancestors hidden from the viewer appear as placeholders
+// @Tags         Pedigree
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Pet ID"
+// @Param        depth query int false "Generations to include (max 6)" default(3)
+// @Success      200  {object}  dto.PedigreeNode
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{id}/pedigree [get]
+func (h *PedigreeHandler) GetPedigree(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	depth, _ := strconv.Atoi(c.DefaultQuery("depth", strconv.Itoa(utils.PedigreeDefaultDepth)))
+
+	resp, err := h.pedigreeService.GetPedigree(userInfo, c.Param("id"), depth)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// GetSiblings godoc
+// @Summary      Get siblings
+// @Description  Pets sharing a linked sire or dam, marked as full or half siblings
+// @Tags         Pedigree
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Pet ID"
+// @Success      200  {object}  []dto.PetRelativeItem
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{id}/siblings [get]
+func (h *PedigreeHandler) GetSiblings(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.pedigreeService.GetSiblings(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// GetOffspring godoc
+// @Summary      Get offspring
+// @Description  Pets that have this pet linked as sire or dam
+// @Tags         Pedigree
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Pet ID"
+// @Success      200  {object}  []dto.PetRelativeItem
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{id}/offspring [get]
+func (h *PedigreeHandler) GetOffspring(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.pedigreeService.GetOffspring(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+func (h *PedigreeHandler) handleError(c *gin.Context, err error) {
+	switch err.Error() {
+	case utils.PetIDNotExist:
+		utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+	case utils.PermissionDenied:
+		utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+	case utils.InvalidParent, utils.ParentGenderMismatch, utils.ParentSpeciesMismatch:
+		utils.BadRequestError(c, utils.ErrCodeInvalidParent, err.Error())
+	case utils.PedigreeCycle:
+		utils.BadRequestError(c, utils.ErrCodePedigreeCycle, utils.PedigreeCycle)
+	default:
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+	}
+}
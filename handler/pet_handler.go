@@ -1,12 +1,12 @@
 package handler
 
 import (
-	"io"
+	"mime/multipart"
+	"pet-service/config"
 	"pet-service/dto"
 	"pet-service/middleware"
 	"pet-service/service"
 	"pet-service/utils"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
@@ -52,7 +52,14 @@ func (h *PetHandler) CreatePet(c *gin.Context) {
 
 	resp, err := h.petService.CreatePet(userInfo, req)
 	if err != nil {
-		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		switch err.Error() {
+		case utils.InvalidSpecies:
+			utils.BadRequestError(c, utils.ErrCodeInvalidSpecies, utils.InvalidSpecies)
+		case utils.InvalidBreed:
+			utils.BadRequestError(c, utils.ErrCodeInvalidBreed, utils.InvalidBreed)
+		default:
+			utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		}
 		return
 	}
 
@@ -61,26 +68,50 @@ func (h *PetHandler) CreatePet(c *gin.Context) {
 
 // GetPets godoc
 // @Summary      Get all pets
-// @Description  Get list of all pets with pagination and filters
+// @Description  Get list of pets visible to the current user with pagination and filters
 // @Tags         Pets
 // @Accept       json
 // @Produce      json
 // @Security     Bearer
-// @Param        page query int false "Page number" default(1)
-// @Param        page_size query int false "Page size" default(10)
-// @Param        search query string false "Search query"
+// @Param        page query int false "Page number (offset pagination)" default(1)
+// @Param        page_size query int false "Page size, at most 100" default(10)
+// @Param        pagination query string false "offset (default) or cursor"
+// @Param        cursor query string false "next_cursor or prev_cursor from the previous page"
+// @Param        include_total query bool false "Count total_items in cursor mode"
+// @Param        search query string false "Full-text search over name, breed and description; results carry a ranked, highlighted match"
 // @Param        name query string false "Pet name filter"
+// @Param        type query string false "Free-text type filter"
+// @Param        species query string false "Species code filter"
+// @Param        breed query string false "Breed code filter"
+// @Param        gender query string false "male or female"
+// @Param        min_age query int false "Minimum age in years"
+// @Param        max_age query int false "Maximum age in years"
+// @Param        owner_id query string false "Owner user ID, or me"
+// @Param        status query string false "alive or deceased"
+// @Param        has_photo query bool false "Only pets with (true) or without (false) an avatar or gallery photo"
+// @Param        sort query string false "relevance, name, date_of_birth or created_at (cursor pages: created_at only)"
+// @Param        order query string false "asc or desc"
 // @Success      200  {object}  dto.PaginationResponse
 // @Failure      400  {object}  dto.ErrorResponse
 // @Router       /pets [get]
 func (h *PetHandler) GetPets(c *gin.Context) {
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
-	search := c.Query("search")
-	name := c.Query("name")
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var params dto.PetListQuery
+	if err := c.ShouldBindQuery(&params); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
 
-	resp, err := h.petService.GetPets(h.db, page, pageSize, search, name)
+	resp, err := h.petService.GetPets(h.db, userInfo, params)
 	if err != nil {
+		if handlePageError(c, err) {
+			return
+		}
 		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
 		return
 	}
@@ -100,9 +131,39 @@ func (h *PetHandler) GetPets(c *gin.Context) {
 // @Failure      400  {object}  dto.ErrorResponse
 // @Router       /pet/{id} [get]
 func (h *PetHandler) GetPetDetail(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
 	petID := c.Param("id")
 
-	resp, err := h.petService.GetPetDetail(petID)
+	resp, err := h.petService.GetPetDetail(&userInfo, petID)
+	if err != nil {
+		if err.Error() == utils.PetIDNotExist {
+			utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+		} else {
+			utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// GetPublicPet godoc
+// @Summary      Get public pet detail
+// @Description  Share link for a public pet; no authentication required
+// @Tags         Pets
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Pet ID"
+// @Success      200  {object}  dto.PetDetailResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /public/pet/{id} [get]
+func (h *PetHandler) GetPublicPet(c *gin.Context) {
+	resp, err := h.petService.GetPetDetail(nil, c.Param("id"))
 	if err != nil {
 		if err.Error() == utils.PetIDNotExist {
 			utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
@@ -115,6 +176,98 @@ func (h *PetHandler) GetPetDetail(c *gin.Context) {
 	utils.SuccessResponse(c, resp)
 }
 
+// UpdatePet godoc
+// @Summary      Update pet
+// @Description  Partially update a pet; type/breed are checked against the species catalog (owner only)
+// @Tags         Pets
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.PetUpdateRequest true "Fields to change"
+// @Success      200  {object}  dto.PetResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id} [patch]
+func (h *PetHandler) UpdatePet(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.PetUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.petService.UpdatePet(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		switch err.Error() {
+		case utils.PetIDNotExist:
+			utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+		case utils.PermissionDenied:
+			utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+		case utils.InvalidSpecies:
+			utils.BadRequestError(c, utils.ErrCodeInvalidSpecies, utils.InvalidSpecies)
+		case utils.InvalidBreed:
+			utils.BadRequestError(c, utils.ErrCodeInvalidBreed, utils.InvalidBreed)
+		case utils.InvalidDate:
+			utils.BadRequestError(c, utils.ErrCodeInvalidInput, utils.InvalidDate)
+		default:
+			utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// UpdateVisibility godoc
+// @Summary      Update pet visibility
+// @Description  Set who can see a pet: public, followers or private (owner only)
+// @Tags         Pets
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.PetVisibilityRequest true "Visibility"
+// @Success      200  {object}  dto.PetResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/visibility [patch]
+func (h *PetHandler) UpdateVisibility(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.PetVisibilityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.petService.UpdateVisibility(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		switch err.Error() {
+		case utils.PetIDNotExist:
+			utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+		case utils.PermissionDenied:
+			utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+		default:
+			utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
 // CreatePetLifeEvent godoc
 // @Summary      Create pet life event
 // @Description  Create a life event for a pet
@@ -142,9 +295,12 @@ func (h *PetHandler) CreatePetLifeEvent(c *gin.Context) {
 
 	resp, err := h.petService.CreatePetLifeEvent(userInfo, req)
 	if err != nil {
-		if err.Error() == utils.PetIDNotExist {
+		switch err.Error() {
+		case utils.PetIDNotExist:
 			utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
-		} else {
+		case utils.PermissionDenied:
+			utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+		default:
 			utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
 		}
 		return
@@ -155,7 +311,8 @@ func (h *PetHandler) CreatePetLifeEvent(c *gin.Context) {
 
 // UploadAvatar godoc
 // @Summary      Upload pet avatar
-// @Description  Upload an avatar image for a pet
+// @Description  Upload an avatar image (JPEG, PNG, GIF or WebP, detected from the file bytes) for a pet; the file is streamed to storage and may be at most UPLOAD_MAX_FILE_SIZE_MB.
+// @Description  The avatar is processed in the background (metadata stripped, variants rendered) and replaces avt_url once ready.
 // @Tags         Pets
 // @Accept       multipart/form-data
 // @Produce      json
@@ -164,34 +321,44 @@ func (h *PetHandler) CreatePetLifeEvent(c *gin.Context) {
 // @Param        file formData file true "Avatar image file"
 // @Success      200  {object}  dto.MessageResponse
 // @Failure      400  {object}  dto.ErrorResponse
+// @Failure      413  {object}  dto.ErrorResponse
 // @Router       /pet/{pet_id}/images [post]
 func (h *PetHandler) UploadAvatar(c *gin.Context) {
-	petID := c.Param("pet_id")
-
-	file, err := c.FormFile("file")
-	if err != nil {
-		utils.BadRequestError(c, utils.ErrCodeInvalidInput, "File is required")
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
 		return
 	}
 
-	openedFile, err := file.Open()
+	petID := c.Param("pet_id")
+
+	limitUploadBody(c, 1, config.AppConfig.UploadMaxFileSize)
+	file, err := c.FormFile("file")
 	if err != nil {
-		utils.BadRequestError(c, utils.ErrCodeInvalidInput, "Cannot open file")
+		if !handleUploadError(c, err) {
+			utils.BadRequestError(c, utils.ErrCodeInvalidInput, "File is required")
+		}
 		return
 	}
-	defer openedFile.Close()
 
-	fileData, err := io.ReadAll(openedFile)
+	uploads, closeUploads, err := openUploads([]*multipart.FileHeader{file}, config.AppConfig.UploadMaxFileSize)
 	if err != nil {
-		utils.BadRequestError(c, utils.ErrCodeInvalidInput, "Cannot read file")
+		handleUploadError(c, err)
 		return
 	}
+	defer closeUploads()
 
-	resp, err := h.petService.UploadAvatar(petID, fileData, file.Header.Get("Content-Type"))
+	resp, err := h.petService.UploadAvatar(userInfo, petID, uploads[0])
 	if err != nil {
-		if err.Error() == utils.PetIDNotExist {
+		if handleUploadError(c, err) {
+			return
+		}
+		switch err.Error() {
+		case utils.PetIDNotExist:
 			utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
-		} else {
+		case utils.PermissionDenied:
+			utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+		default:
 			utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
 		}
 		return
@@ -202,7 +369,8 @@ func (h *PetHandler) UploadAvatar(c *gin.Context) {
 
 // UploadGallery godoc
 // @Summary      Upload pet gallery images
-// @Description  Upload multiple images to pet gallery
+// @Description  Upload multiple images or videos (MP4, WebM, QuickTime) to pet gallery; photos start in status processing until their metadata is stripped and variants are rendered; types are detected from the file bytes and one rejected file, or one that can't be stored, fails the request. Up to UPLOAD_MAX_FILES files of at most UPLOAD_MAX_FILE_SIZE_MB each are streamed to storage a few at a time. A file the pet already has (same SHA-256) returns the existing item instead of a copy.
+// @Description  Videos may be up to VIDEO_MAX_FILE_SIZE_MB and VIDEO_MAX_DURATION_SECONDS long; their duration and dimensions are returned, and they stay processing until a poster frame has been extracted.
 // @Tags         Pets
 // @Accept       multipart/form-data
 // @Produce      json
@@ -211,13 +379,24 @@ func (h *PetHandler) UploadAvatar(c *gin.Context) {
 // @Param        files formData file true "Gallery image files" 
 // @Success      200  {object}  dto.MessageResponse
 // @Failure      400  {object}  dto.ErrorResponse
+// @Failure      413  {object}  dto.ErrorResponse
+// @Failure      500  {object}  dto.ErrorResponse
 // @Router       /pet/{pet_id}/gallery [post]
 func (h *PetHandler) UploadGallery(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
 	petID := c.Param("pet_id")
 
+	limitUploadBody(c, config.AppConfig.UploadMaxFiles, galleryMaxFileSize())
 	form, err := c.MultipartForm()
 	if err != nil {
-		utils.BadRequestError(c, utils.ErrCodeInvalidInput, "Files are required")
+		if !handleUploadError(c, err) {
+			utils.BadRequestError(c, utils.ErrCodeInvalidInput, "Files are required")
+		}
 		return
 	}
 
@@ -227,31 +406,127 @@ func (h *PetHandler) UploadGallery(c *gin.Context) {
 		return
 	}
 
-	var fileReaders []io.Reader
-	var fileNames []string
-	var contentTypes []string
+	uploads, closeUploads, err := openUploads(files, galleryMaxFileSize())
+	if err != nil {
+		handleUploadError(c, err)
+		return
+	}
+	defer closeUploads()
 
-	for _, file := range files {
-		openedFile, err := file.Open()
-		if err != nil {
-			continue
+	resp, err := h.petService.UploadGallery(userInfo, petID, uploads)
+	if err != nil {
+		if handleUploadError(c, err) {
+			return
+		}
+		switch err.Error() {
+		case utils.PetIDNotExist:
+			utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+		case utils.PermissionDenied:
+			utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+		default:
+			utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
 		}
-		defer openedFile.Close()
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
 
-		fileReaders = append(fileReaders, openedFile)
-		fileNames = append(fileNames, file.Filename)
-		contentTypes = append(contentTypes, file.Header.Get("Content-Type"))
+// CreateMediaUploadURL godoc
+// @Summary      Get a direct upload policy for a gallery file
+// @Description  Issue a presigned POST policy so a client can send one image or video straight to storage instead of through the API. The policy pins the declared content_type and caps the size at the declared size; it expires after DIRECT_UPLOAD_EXPIRY_MINUTES.
+// @Description  POST the returned fields, then the file as the last form field named "file", to url; then call /pet/{pet_id}/media/complete. Uploads never completed are removed.
+// @Tags         Pets
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.MediaUploadURLRequest true "File to upload"
+// @Success      201  {object}  dto.MediaUploadURLResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/media/upload-url [post]
+func (h *PetHandler) CreateMediaUploadURL(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
 	}
 
-	resp, err := h.petService.UploadGallery(petID, fileReaders, fileNames, contentTypes)
+	var req dto.MediaUploadURLRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.petService.CreateUploadURL(userInfo, c.Param("pet_id"), req)
 	if err != nil {
-		if err.Error() == utils.PetIDNotExist {
+		if handleUploadError(c, err) {
+			return
+		}
+		switch err.Error() {
+		case utils.PetIDNotExist:
 			utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
-		} else {
+		case utils.PermissionDenied:
+			utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+		default:
 			utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
 		}
 		return
 	}
 
-	utils.SuccessResponse(c, resp)
+	utils.CreatedResponse(c, resp)
+}
+
+// CompleteMediaUpload godoc
+// @Summary      Complete a direct gallery upload
+// @Description  Check a file posted with a /pet/{pet_id}/media/upload-url policy (size, and type detected from its bytes; videos are probed for duration) and add it to the gallery. It then goes through processing like a file sent to /pet/{pet_id}/gallery, and a file the pet already has returns the existing item.
+// @Description  Returns 409 while the file has not reached storage; a file that fails the checks is removed and its upload can't be completed again.
+// @Tags         Pets
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Param        request body dto.MediaUploadCompleteRequest true "Upload to complete"
+// @Success      201  {object}  dto.MediaResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Failure      409  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/media/complete [post]
+func (h *PetHandler) CompleteMediaUpload(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.MediaUploadCompleteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.petService.CompleteUpload(userInfo, c.Param("pet_id"), req)
+	if err != nil {
+		if handleUploadError(c, err) {
+			return
+		}
+		switch err.Error() {
+		case utils.PetIDNotExist:
+			utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+		case utils.PermissionDenied:
+			utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+		case utils.UploadNotExist:
+			utils.NotFoundError(c, utils.ErrCodeUploadNotFound, utils.UploadNotExist)
+		case utils.UploadNotReceived:
+			utils.ConflictError(c, utils.ErrCodeUploadIncomplete, utils.UploadNotReceived)
+		default:
+			utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		}
+		return
+	}
+
+	utils.CreatedResponse(c, resp)
 }
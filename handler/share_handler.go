@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"net/http"
+	"pet-service/middleware"
+	"pet-service/service"
+	"pet-service/utils"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ShareHandler struct {
+	shareService service.IShareService
+}
+
+// NewShareHandler creates a new share link handler instance
+func NewShareHandler(shareService service.IShareService) *ShareHandler {
+	return &ShareHandler{
+		shareService: shareService,
+	}
+}
+
+// GetShareLink godoc
+// @Summary      Get pet share link
+// @Description  Get the active public slug for a pet, creating one if needed (owner only)
+// @Tags         Share
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path string true "Pet ID"
+// @Success      200  {object}  dto.ShareLinkResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{id}/share-link [get]
+func (h *ShareHandler) GetShareLink(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.shareService.GetShareLink(userInfo, c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// RotateShareLink godoc
+// @Summary      Rotate pet share link
+// @Description  Revoke the current slug and issue a new one, e.g. when a tag is lost (owner only)
+// @Tags         Share
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Success      201  {object}  dto.ShareLinkResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/share-link [post]
+func (h *ShareHandler) RotateShareLink(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.shareService.RotateShareLink(userInfo, c.Param("pet_id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.CreatedResponse(c, resp)
+}
+
+// RevokeShareLink godoc
+// @Summary      Revoke pet share link
+// @Description  Invalidate the current slug without issuing a new one (owner only)
+// @Tags         Share
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        pet_id path string true "Pet ID"
+// @Success      200  {object}  dto.MessageResponse
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{pet_id}/share-link [delete]
+func (h *ShareHandler) RevokeShareLink(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	resp, err := h.shareService.RevokeShareLink(userInfo, c.Param("pet_id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+// GetQRCode godoc
+// @Summary      Get pet QR code
+// @Description  PNG QR code pointing at the pet's public profile page (owner only)
+// @Tags         Share
+// @Produce      png
+// @Security     Bearer
+// @Param        id path string true "Pet ID"
+// @Param        size query int false "Image size in pixels" default(512)
+// @Success      200  {file}    binary
+// @Failure      403  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /pet/{id}/qr.png [get]
+func (h *ShareHandler) GetQRCode(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	size, _ := strconv.Atoi(c.Query("size"))
+
+	png, err := h.shareService.GetQRCode(userInfo, c.Param("id"), size)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-cache")
+	c.Data(http.StatusOK, "image/png", png)
+}
+
+// GetPublicProfile godoc
+// @Summary      Get public pet profile
+// @Description  Public profile behind a pet's share slug; no authentication required
+// @Tags         Share
+// @Accept       json
+// @Produce      json
+// @Param        slug path string true "Share slug"
+// @Success      200  {object}  dto.PublicPetProfileResponse
+// @Failure      404  {object}  dto.ErrorResponse
+// @Router       /p/{slug} [get]
+func (h *ShareHandler) GetPublicProfile(c *gin.Context) {
+	resp, err := h.shareService.GetPublicProfile(c.Param("slug"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
+func (h *ShareHandler) handleError(c *gin.Context, err error) {
+	switch err.Error() {
+	case utils.PetIDNotExist:
+		utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
+	case utils.ShareLinkNotExist:
+		utils.NotFoundError(c, utils.ErrCodeShareLinkNotFound, utils.ShareLinkNotExist)
+	case utils.PermissionDenied:
+		utils.ForbiddenError(c, utils.ErrCodePermissionDenied, utils.PermissionDenied)
+	default:
+		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+	}
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"pet-service/config"
+	"pet-service/service"
+	"pet-service/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// multipartOverhead allows for form fields and part headers on top of the file bytes
+const multipartOverhead = 1 << 20
+
+// limitUploadBody caps the request body at maxFiles files of maxFileSize, so an oversized
+// request fails while its form is parsed instead of after it was spooled to disk
+func limitUploadBody(c *gin.Context, maxFiles int, maxFileSize int64) {
+	limit := int64(maxFiles)*maxFileSize + multipartOverhead
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+}
+
+// openUploads checks the number and size of the files from their multipart headers,
+// then opens them for streaming. The returned func closes every opened file.
+func openUploads(headers []*multipart.FileHeader, maxFileSize int64) ([]service.UploadFile, func(), error) {
+	if len(headers) > config.AppConfig.UploadMaxFiles {
+		return nil, func() {}, errors.New(utils.UploadTooManyFiles)
+	}
+	for _, header := range headers {
+		if header.Size > maxFileSize {
+			return nil, func() {}, errors.New(utils.UploadFileTooLarge)
+		}
+	}
+
+	var opened []multipart.File
+	closeAll := func() {
+		for _, file := range opened {
+			file.Close()
+		}
+	}
+
+	files := make([]service.UploadFile, 0, len(headers))
+	for _, header := range headers {
+		file, err := header.Open()
+		if err != nil {
+			closeAll()
+			return nil, func() {}, errors.New(utils.UploadFileUnreadable)
+		}
+		opened = append(opened, file)
+
+		files = append(files, service.UploadFile{
+			Reader:      file,
+			Name:        header.Filename,
+			ContentType: header.Header.Get("Content-Type"),
+			Size:        header.Size,
+		})
+	}
+
+	return files, closeAll, nil
+}
+
+// galleryMaxFileSize is the largest file a gallery accepts; the service applies the
+// photo and video caps once it knows each file's type
+func galleryMaxFileSize() int64 {
+	return max(config.AppConfig.UploadMaxFileSize, config.AppConfig.VideoMaxFileSize)
+}
+
+// handleUploadError answers rejected uploads: limit errors, including a body cut off
+// by limitUploadBody while the form was parsed, and files of the wrong type
+func handleUploadError(c *gin.Context, err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	var fileErr *utils.FileValidationError
+	switch {
+	case errors.As(err, &fileErr):
+		utils.ValidationError(c, fileErr)
+	case errors.As(err, &maxBytesErr):
+		utils.PayloadTooLargeError(c, utils.ErrCodeUploadTooLarge, utils.UploadFileTooLarge)
+	case err.Error() == utils.UploadFileTooLarge:
+		utils.PayloadTooLargeError(c, utils.ErrCodeUploadTooLarge, utils.UploadFileTooLarge)
+	case err.Error() == utils.UploadTooManyFiles:
+		utils.BadRequestError(c, utils.ErrCodeTooManyFiles, utils.UploadTooManyFiles)
+	case err.Error() == utils.UploadFileUnreadable:
+		utils.BadRequestError(c, utils.ErrCodeInvalidInput, utils.UploadFileUnreadable)
+	default:
+		return false
+	}
+	return true
+}
@@ -146,17 +146,37 @@ func (h *UserHandler) Logout(c *gin.Context) {
 
 // GetUsers godoc
 // @Summary      Get all users
-// @Description  Get list of all users
+// @Description  Get list of all users, newest first. Email and phone are only shown where the user made them public; admins see them all.
 // @Tags         Users
 // @Accept       json
 // @Produce      json
 // @Security     Bearer
-// @Success      200  {object}  []dto.UserResponse
+// @Param        page query int false "Page number (offset pagination)" default(1)
+// @Param        page_size query int false "Page size, at most 100" default(10)
+// @Param        pagination query string false "offset (default) or cursor"
+// @Param        cursor query string false "next_cursor or prev_cursor from the previous page"
+// @Param        include_total query bool false "Count total_items in cursor mode"
+// @Success      200  {object}  dto.PaginationResponse{data=[]dto.UserResponse}
 // @Failure      400  {object}  dto.ErrorResponse
 // @Router       /users [get]
 func (h *UserHandler) GetUsers(c *gin.Context) {
-	resp, err := h.userService.GetUsers()
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var query dto.PageQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.userService.GetUsers(userInfo, query)
 	if err != nil {
+		if handlePageError(c, err) {
+			return
+		}
 		utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
 		return
 	}
@@ -202,6 +222,43 @@ func (h *UserHandler) ChangePassword(c *gin.Context) {
 	utils.SuccessResponse(c, resp)
 }
 
+// UpdatePrivacy godoc
+// @Summary      Update profile privacy
+// @Description  Choose who can see the email and phone shown on your pets' owner profile
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body dto.UserPrivacyRequest true "Privacy settings"
+// @Success      200  {object}  dto.UserResponse
+// @Failure      400  {object}  dto.ErrorResponse
+// @Router       /users/privacy [patch]
+func (h *UserHandler) UpdatePrivacy(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req dto.UserPrivacyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.userService.UpdatePrivacy(userInfo, req)
+	if err != nil {
+		if err.Error() == utils.UserIsNotExist {
+			utils.NotFoundError(c, utils.ErrCodeUserNotFound, utils.UserIsNotExist)
+		} else {
+			utils.InternalServerError(c, utils.ErrCodeInternalError, err.Error())
+		}
+		return
+	}
+
+	utils.SuccessResponse(c, resp)
+}
+
 // CreateComment godoc
 // @Summary      Create comment
 // @Description  Create a comment on a pet post
@@ -213,7 +270,7 @@ func (h *UserHandler) ChangePassword(c *gin.Context) {
 // @Param        request body dto.CommentRequest true "Comment data"
 // @Success      200  {object}  dto.CommentResponse
 // @Failure      400  {object}  dto.ErrorResponse
-// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
 // @Router       /post/{pet_id}/comment [post]
 func (h *UserHandler) CreateComment(c *gin.Context) {
 	userInfo, exists := middleware.GetCurrentUser(c)
@@ -290,20 +347,41 @@ func (h *UserHandler) EditComment(c *gin.Context) {
 
 // GetComments godoc
 // @Summary      Get comments
-// @Description  Get all comments for a pet
+// @Description  Get comments for a pet, newest first
 // @Tags         Comments
 // @Accept       json
 // @Produce      json
 // @Security     Bearer
 // @Param        pet_id path string true "Pet ID"
-// @Success      200  {object}  []dto.CommentResponse
+// @Param        page query int false "Page number (offset pagination)" default(1)
+// @Param        page_size query int false "Page size, at most 100" default(10)
+// @Param        pagination query string false "offset (default) or cursor"
+// @Param        cursor query string false "next_cursor or prev_cursor from the previous page"
+// @Param        include_total query bool false "Count total_items in cursor mode"
+// @Success      200  {object}  dto.PaginationResponse{data=[]dto.CommentResponse}
 // @Failure      400  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.ErrorResponse
 // @Router       /post/{pet_id}/comments [get]
 func (h *UserHandler) GetComments(c *gin.Context) {
+	userInfo, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		utils.UnauthorizedError(c, utils.ErrCodeUnauthorized, "Unauthorized")
+		return
+	}
+
 	petID := c.Param("pet_id")
 
-	resp, err := h.userService.GetCommentsByPetID(petID)
+	var query dto.PageQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.ValidationError(c, err)
+		return
+	}
+
+	resp, err := h.userService.GetCommentsByPetID(userInfo, petID, query)
 	if err != nil {
+		if handlePageError(c, err) {
+			return
+		}
 		if err.Error() == utils.PetIDNotExist {
 			utils.NotFoundError(c, utils.ErrCodePetNotFound, utils.PetIDNotExist)
 		} else {
@@ -3,6 +3,7 @@ package main
 import (
 	"log"
 	"pet-service/config"
+	"pet-service/container"
 	"pet-service/database"
 	_ "pet-service/docs" // Swagger docs
 	"pet-service/middleware"
@@ -41,9 +42,10 @@ func main() {
 	// Connect to database
 	database.ConnectDatabase()
 
-	// Initialize MinIO
-	if err := storage.InitMinio(); err != nil {
-		log.Fatalf("Failed to initialize MinIO: %v", err)
+	// Initialize the storage backend (MinIO, local files or in-memory)
+	store, err := storage.NewBlobStore(config.AppConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize %s storage: %v", config.AppConfig.StorageBackend, err)
 	}
 
 	// Initialize scheduler
@@ -55,6 +57,8 @@ func main() {
 	}
 
 	router := gin.Default()
+	// Keep little of each multipart upload in memory; the rest is spooled to temp files
+	router.MaxMultipartMemory = 8 << 20
 
 	// Middleware
 	router.Use(middleware.LoggingMiddleware())
@@ -72,8 +76,44 @@ func main() {
 		c.Next()
 	})
 
+	// Initialize dependency injection container
+	c := container.NewContainer(database.GetDB(), store)
+
+	// Daily vaccination/medication due-date reminders
+	reminderJob := scheduler.NewReminderJob(c.Repos.Reminder, c.Services.Notification,
+		config.AppConfig.ReminderWindowsDays, config.AppConfig.PublicWebURL)
+	if _, err := scheduler.GetScheduler().AddJob(config.AppConfig.ReminderCron, reminderJob.Run); err != nil {
+		log.Printf("Failed to schedule reminder job: %v", err)
+	}
+
+	// Export archives past their retention
+	if _, err := scheduler.GetScheduler().AddJob(config.AppConfig.ExportCleanupCron, c.Services.Export.CleanupExpiredExports); err != nil {
+		log.Printf("Failed to schedule export cleanup job: %v", err)
+	}
+
+	// Direct-to-storage uploads that were never completed
+	if _, err := scheduler.GetScheduler().AddJob(config.AppConfig.UploadCleanupCron, c.Services.Pet.CleanupExpiredUploads); err != nil {
+		log.Printf("Failed to schedule upload cleanup job: %v", err)
+	}
+
+	// Stored files nothing refers to any more, and rows whose files are missing
+	if _, err := scheduler.GetScheduler().AddJob(config.AppConfig.StorageReconcileCron, c.Services.Storage.ReconcileStorage); err != nil {
+		log.Printf("Failed to schedule storage reconcile job: %v", err)
+	}
+
+	// Background exports, imports and gallery processing lost to a restart
+	sweepJobs := func() {
+		c.Services.Export.FailStaleExports()
+		c.Services.Import.FailStaleImports()
+		c.Services.Pet.FailStaleMedias()
+	}
+	sweepJobs()
+	if _, err := scheduler.GetScheduler().AddJob(config.AppConfig.JobSweepCron, sweepJobs); err != nil {
+		log.Printf("Failed to schedule job sweep: %v", err)
+	}
+
 	// Setup routes
-	routes.SetupRoutes(router, database.GetDB())
+	routes.SetupRoutes(router, c)
 
 	// Swagger documentation
 	log.Printf("Debug mode: %v", config.AppConfig.Debug)
@@ -41,58 +41,75 @@ func AuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		tokenString := parts[1]
-
-		// Parse and validate token
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			return []byte(config.AppConfig.SecretKey), nil
-		})
-
-		if err != nil || !token.Valid {
-			c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(utils.ErrCodeInvalidToken, utils.ErrorInvalidToken))
+		userInfo, errCode, errMsg := parseAccessToken(parts[1])
+		if errCode != "" {
+			c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(errCode, errMsg))
 			c.Abort()
 			return
 		}
 
-		// Extract claims
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(utils.ErrCodeInvalidToken, utils.ErrorInvalidToken))
-			c.Abort()
-			return
-		}
+		// Set user info in context
+		c.Set("current_user", userInfo)
+		c.Next()
+	}
+}
 
-		// Check token type
-		tokenType, _ := claims["token_type"].(string)
-		if tokenType != "access_token" {
-			c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(utils.ErrCodeInvalidToken, utils.ErrorInvalidToken))
-			c.Abort()
-			return
+// OptionalAuthMiddleware sets the current user when a valid token is sent,
+// but lets anonymous requests through
+func OptionalAuthMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		parts := strings.Split(c.GetHeader("Authorization"), " ")
+		if len(parts) == 2 && parts[0] == "Bearer" {
+			if userInfo, errCode, _ := parseAccessToken(parts[1]); errCode == "" {
+				c.Set("current_user", userInfo)
+			}
 		}
+		c.Next()
+	}
+}
 
-		// Check if token is blacklisted
-		jti, _ := claims["jti"].(string)
-		if isBlacklisted(jti) {
-			c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(utils.ErrCodeUnauthorized, utils.JTIInBlacklist))
-			c.Abort()
-			return
-		}
+// parseAccessToken validates an access token and extracts the user info;
+// on failure it returns the error code and message to respond with
+func parseAccessToken(tokenString string) (UserInfo, string, string) {
+	// Parse and validate token
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		return []byte(config.AppConfig.SecretKey), nil
+	})
 
-		// Set user info in context
-		userInfo := UserInfo{
-			UserID:    claims["user_id"].(string),
-			Username:  claims["username"].(string),
-			FirstName: claims["first_name"].(string),
-			LastName:  claims["last_name"].(string),
-			Email:     claims["email"].(string),
-			IsAdmin:   claims["is_admin"].(bool),
-			JTI:       jti,
-			TokenType: tokenType,
-		}
+	if err != nil || !token.Valid {
+		return UserInfo{}, utils.ErrCodeInvalidToken, utils.ErrorInvalidToken
+	}
 
-		c.Set("current_user", userInfo)
-		c.Next()
+	// Extract claims
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return UserInfo{}, utils.ErrCodeInvalidToken, utils.ErrorInvalidToken
+	}
+
+	// Check token type
+	tokenType, _ := claims["token_type"].(string)
+	if tokenType != "access_token" {
+		return UserInfo{}, utils.ErrCodeInvalidToken, utils.ErrorInvalidToken
+	}
+
+	// Check if token is blacklisted
+	jti, _ := claims["jti"].(string)
+	if isBlacklisted(jti) {
+		return UserInfo{}, utils.ErrCodeUnauthorized, utils.JTIInBlacklist
+	}
+
+	userInfo := UserInfo{
+		UserID:    claims["user_id"].(string),
+		Username:  claims["username"].(string),
+		FirstName: claims["first_name"].(string),
+		LastName:  claims["last_name"].(string),
+		Email:     claims["email"].(string),
+		IsAdmin:   claims["is_admin"].(bool),
+		JTI:       jti,
+		TokenType: tokenType,
 	}
+
+	return userInfo, "", ""
 }
 
 // PermissionMiddleware checks if user has required permissions
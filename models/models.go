@@ -26,19 +26,21 @@ func (base *BaseModel) BeforeCreate(tx *gorm.DB) error {
 // User model
 type User struct {
 	BaseModel
-	FirstName     string         `gorm:"type:varchar(105);not null" json:"first_name"`
-	LastName      string         `gorm:"type:varchar(105);not null" json:"last_name"`
-	Email         string         `gorm:"type:varchar(100)" json:"email"`
-	Phone         string         `gorm:"type:varchar(12)" json:"phone"`
-	Username      string         `gorm:"type:varchar(50);unique;index;comment:tên tài khoản" json:"username"`
-	Gender        bool           `gorm:"default:true" json:"gender"`
-	Password      string         `gorm:"type:varchar(100);not null" json:"-"`
-	AvatarURL     string         `gorm:"type:varchar(255)" json:"avatar_url"`
-	IsAdmin       bool           `gorm:"default:false" json:"is_admin"`
-	Roles         []Role         `gorm:"many2many:user_roles" json:"roles,omitempty"`
-	Pets          []Pet          `gorm:"foreignKey:UserID" json:"pets,omitempty"`
-	LoginHistory  []LoginHistory `gorm:"foreignKey:UserID" json:"-"`
-	Appointments  []Appointment  `gorm:"foreignKey:UserID" json:"appointments,omitempty"`
+	FirstName       string         `gorm:"type:varchar(105);not null" json:"first_name"`
+	LastName        string         `gorm:"type:varchar(105);not null" json:"last_name"`
+	Email           string         `gorm:"type:varchar(100)" json:"email"`
+	Phone           string         `gorm:"type:varchar(12)" json:"phone"`
+	Username        string         `gorm:"type:varchar(50);unique;index;comment:tên tài khoản" json:"username"`
+	Gender          bool           `gorm:"default:true" json:"gender"`
+	Password        string         `gorm:"type:varchar(100);not null" json:"-"`
+	AvatarURL       string         `gorm:"type:varchar(255)" json:"avatar_url"`
+	IsAdmin         bool           `gorm:"default:false" json:"is_admin"`
+	EmailVisibility string         `gorm:"type:varchar(20);default:private" json:"email_visibility"`
+	PhoneVisibility string         `gorm:"type:varchar(20);default:private" json:"phone_visibility"`
+	Roles           []Role         `gorm:"many2many:user_roles" json:"roles,omitempty"`
+	Pets            []Pet          `gorm:"foreignKey:UserID" json:"pets,omitempty"`
+	LoginHistory    []LoginHistory `gorm:"foreignKey:UserID" json:"-"`
+	Appointments    []Appointment  `gorm:"foreignKey:UserID" json:"appointments,omitempty"`
 }
 
 func (User) TableName() string {
@@ -91,39 +93,114 @@ func (UserRole) TableName() string {
 // Pet model
 type Pet struct {
 	BaseModel
-	Name         string         `gorm:"type:varchar(105);not null;comment:Tên" json:"name"`
-	DateOfBirth  *time.Time     `json:"date_of_birth"`
-	DateOfDeath  *time.Time     `json:"date_of_death"`
-	Gender       bool           `gorm:"default:true" json:"gender"`
-	Breed        string         `gorm:"type:varchar(50)" json:"breed"`
-	Description  string         `gorm:"type:varchar(255)" json:"description"`
-	AvtURL       string         `gorm:"type:varchar(255)" json:"avt_url"`
-	Type         string         `gorm:"type:varchar(50)" json:"type"`
-	UserID       string         `gorm:"type:varchar(36);not null" json:"user_id"`
-	User         User           `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	Medias       []Media        `gorm:"foreignKey:PetID" json:"medias,omitempty"`
-	LifeEvents   []PetLifeEvent `gorm:"foreignKey:PetID" json:"life_events,omitempty"`
-	Comments     []Comment      `gorm:"foreignKey:PetID" json:"comments,omitempty"`
+	Name             string         `gorm:"type:varchar(105);not null;comment:Tên" json:"name"`
+	DateOfBirth      *time.Time     `json:"date_of_birth"`
+	DateOfDeath      *time.Time     `json:"date_of_death"`
+	Gender           bool           `gorm:"default:true" json:"gender"`
+	Breed            string         `gorm:"type:varchar(50)" json:"breed"`
+	Description      string         `gorm:"type:varchar(255)" json:"description"`
+	AvtKey           string         `gorm:"column:avt_url;type:varchar(255)" json:"avt_key"`
+	AvtVariants      ImageVariants  `gorm:"type:text;serializer:json" json:"avt_variants"`
+	CoverMediaID     string         `gorm:"type:varchar(36)" json:"cover_media_id"`
+	Type             string         `gorm:"type:varchar(50)" json:"type"`
+	SpeciesCode      string         `gorm:"type:varchar(50);index" json:"species_code"`
+	BreedCode        string         `gorm:"type:varchar(100);index" json:"breed_code"`
+	Visibility       string         `gorm:"type:varchar(20);default:public;index" json:"visibility"`
+	IsLost           bool           `gorm:"default:false;index" json:"is_lost"`
+	LostAt           *time.Time     `json:"lost_at"`
+	LastSeenAt       *time.Time     `json:"last_seen_at"`
+	LastSeenLocation string         `gorm:"type:varchar(255)" json:"last_seen_location"`
+	LastSeenLat      *float64       `json:"last_seen_lat"`
+	LastSeenLng      *float64       `json:"last_seen_lng"`
+	SireID           *string        `gorm:"type:varchar(36);index" json:"sire_id"`
+	DamID            *string        `gorm:"type:varchar(36);index" json:"dam_id"`
+	SireName         string         `gorm:"type:varchar(105)" json:"sire_name"`         // external, name-only sire
+	DamName          string         `gorm:"type:varchar(105)" json:"dam_name"`          // external, name-only dam
+	ExternalID       string         `gorm:"type:varchar(100);index" json:"external_id"` // ID in the owner's own records, set by bulk import
+	UserID           string         `gorm:"type:varchar(36);not null" json:"user_id"`
+	User             User           `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Medias           []Media        `gorm:"foreignKey:PetID" json:"medias,omitempty"`
+	LifeEvents       []PetLifeEvent `gorm:"foreignKey:PetID" json:"life_events,omitempty"`
+	Comments         []Comment      `gorm:"foreignKey:PetID" json:"comments,omitempty"`
+	Members          []PetMember    `gorm:"foreignKey:PetID" json:"members,omitempty"`
 }
 
 func (Pet) TableName() string {
 	return "pets"
 }
 
-// Media model
+// Media model. Key and Variants hold storage object keys; links are generated when read.
 type Media struct {
 	BaseModel
-	Type  string `gorm:"type:varchar(50)" json:"type"`
-	Name  string `gorm:"type:varchar(105);not null" json:"name"`
-	URL   string `gorm:"type:varchar(255)" json:"url"`
-	PetID string `gorm:"type:varchar(36)" json:"pet_id"`
-	Pet   Pet    `gorm:"foreignKey:PetID" json:"pet,omitempty"`
-}
+	Type        string        `gorm:"type:varchar(50)" json:"type"`
+	Name        string        `gorm:"type:varchar(105);not null" json:"name"`
+	Key         string        `gorm:"column:url;type:varchar(255)" json:"key"`
+	Status      string        `gorm:"type:varchar(20);default:ready;index" json:"status"`
+	Variants    ImageVariants `gorm:"type:text;serializer:json" json:"variants"`
+	ContentHash string        `gorm:"type:varchar(64);index" json:"content_hash"` // SHA-256 of the upload; see MediaBlob
+	Duration    float64       `json:"duration"`                                   // seconds; videos only
+	Width       int           `json:"width"`
+	Height      int           `json:"height"`
+	Caption     string        `gorm:"type:varchar(500)" json:"caption"`
+	Position    int           `gorm:"default:0;index" json:"position"` // gallery order, ascending
+	AlbumID     *string       `gorm:"type:varchar(36);index" json:"album_id"`
+	PetID       string        `gorm:"type:varchar(36)" json:"pet_id"`
+	Pet         Pet           `gorm:"foreignKey:PetID" json:"pet,omitempty"`
+}
+
+// ImageVariants maps resized copies of a photo (thumb, medium, large) to their object keys.
+// Videos also list their poster frame.
+type ImageVariants map[string]string
 
 func (Media) TableName() string {
 	return "medias"
 }
 
+// MediaAlbum is a named group of a pet's gallery items
+type MediaAlbum struct {
+	BaseModel
+	PetID        string `gorm:"type:varchar(36);not null;index" json:"pet_id"`
+	Name         string `gorm:"type:varchar(105);not null" json:"name"`
+	Description  string `gorm:"type:varchar(255)" json:"description"`
+	CoverMediaID string `gorm:"type:varchar(36)" json:"cover_media_id"`
+}
+
+func (MediaAlbum) TableName() string {
+	return "media_albums"
+}
+
+// MediaBlob is a gallery file stored once, under a key derived from its content hash,
+// for every Media with that ContentHash across pets and users. RefCount counts those
+// items; the objects are removed along with the last of them.
+type MediaBlob struct {
+	BaseModel
+	Hash     string        `gorm:"type:varchar(64);not null;uniqueIndex" json:"hash"`
+	Key      string        `gorm:"type:varchar(255);not null" json:"key"`
+	Variants ImageVariants `gorm:"type:text;serializer:json" json:"variants"`
+	RefCount int           `gorm:"not null;default:0" json:"ref_count"`
+	Ready    bool          `gorm:"not null;default:false" json:"ready"` // objects fully stored
+}
+
+func (MediaBlob) TableName() string {
+	return "media_blobs"
+}
+
+// MediaUpload is a gallery file a client sends straight to storage. The object waits
+// under incoming/<id> until the upload is completed, when the same ID becomes the Media's.
+type MediaUpload struct {
+	BaseModel
+	PetID       string    `gorm:"type:varchar(36);not null;index" json:"pet_id"`
+	Name        string    `gorm:"type:varchar(105);not null" json:"name"`
+	ContentType string    `gorm:"type:varchar(100);not null" json:"content_type"`
+	MaxSize     int64     `gorm:"not null" json:"max_size"`
+	Status      string    `gorm:"type:varchar(20);not null;default:pending;index" json:"status"`
+	ExpiresAt   time.Time `gorm:"not null;index" json:"expires_at"`
+}
+
+func (MediaUpload) TableName() string {
+	return "media_uploads"
+}
+
 // Comment model
 type Comment struct {
 	BaseModel
@@ -152,6 +229,136 @@ func (PetLifeEvent) TableName() string {
 	return "pet_life_events"
 }
 
+// Vaccination model
+type Vaccination struct {
+	BaseModel
+	PetID          string     `gorm:"type:varchar(36);not null;index" json:"pet_id"`
+	AppointmentID  string     `gorm:"type:varchar(36);index" json:"appointment_id"`
+	Vaccine        string     `gorm:"type:varchar(105);not null" json:"vaccine"`
+	BatchNumber    string     `gorm:"type:varchar(50)" json:"batch_number"`
+	AdministeredAt time.Time  `gorm:"not null" json:"administered_at"`
+	NextDueAt      *time.Time `gorm:"index" json:"next_due_at"`
+	Veterinarian   string     `gorm:"type:varchar(105)" json:"veterinarian"`
+	Notes          string     `gorm:"type:text" json:"notes"`
+	Pet            Pet        `gorm:"foreignKey:PetID" json:"pet,omitempty"`
+}
+
+func (Vaccination) TableName() string {
+	return "vaccinations"
+}
+
+// Treatment model (diagnoses and procedures)
+type Treatment struct {
+	BaseModel
+	PetID         string    `gorm:"type:varchar(36);not null;index" json:"pet_id"`
+	AppointmentID string    `gorm:"type:varchar(36);index" json:"appointment_id"`
+	Diagnosis     string    `gorm:"type:varchar(255);not null" json:"diagnosis"`
+	Treatment     string    `gorm:"type:text" json:"treatment"`
+	TreatedAt     time.Time `gorm:"not null" json:"treated_at"`
+	Veterinarian  string    `gorm:"type:varchar(105)" json:"veterinarian"`
+	Notes         string    `gorm:"type:text" json:"notes"`
+	Pet           Pet       `gorm:"foreignKey:PetID" json:"pet,omitempty"`
+}
+
+func (Treatment) TableName() string {
+	return "treatments"
+}
+
+// Medication model (a course of medication with dosage and schedule)
+type Medication struct {
+	BaseModel
+	PetID         string     `gorm:"type:varchar(36);not null;index" json:"pet_id"`
+	AppointmentID string     `gorm:"type:varchar(36);index" json:"appointment_id"`
+	Name          string     `gorm:"type:varchar(105);not null" json:"name"`
+	Dosage        string     `gorm:"type:varchar(100);not null" json:"dosage"`
+	Frequency     string     `gorm:"type:varchar(100)" json:"frequency"`
+	StartDate     time.Time  `gorm:"not null" json:"start_date"`
+	EndDate       *time.Time `json:"end_date"`
+	NextDueAt     *time.Time `gorm:"index" json:"next_due_at"`
+	Instructions  string     `gorm:"type:text" json:"instructions"`
+	PrescribedBy  string     `gorm:"type:varchar(105)" json:"prescribed_by"`
+	Pet           Pet        `gorm:"foreignKey:PetID" json:"pet,omitempty"`
+}
+
+func (Medication) TableName() string {
+	return "medications"
+}
+
+// PetMeasurement model (weight, body condition score and height readings)
+type PetMeasurement struct {
+	BaseModel
+	PetID              string    `gorm:"type:varchar(36);not null;index:idx_pet_measurements_pet_measured" json:"pet_id"`
+	MeasuredAt         time.Time `gorm:"not null;index:idx_pet_measurements_pet_measured" json:"measured_at"`
+	WeightKg           *float64  `json:"weight_kg"`
+	BodyConditionScore *int      `json:"body_condition_score"`
+	HeightCm           *float64  `json:"height_cm"`
+	Notes              string    `gorm:"type:varchar(255)" json:"notes"`
+	AppointmentID      string    `gorm:"type:varchar(36);index" json:"appointment_id"`
+	StaffID            *string   `gorm:"type:varchar(36)" json:"staff_id"`
+	Staff              *User     `gorm:"foreignKey:StaffID" json:"staff,omitempty"`
+	Pet                Pet       `gorm:"foreignKey:PetID" json:"pet,omitempty"`
+}
+
+func (PetMeasurement) TableName() string {
+	return "pet_measurements"
+}
+
+// BreedReferenceRange model (typical adult size for a breed)
+type BreedReferenceRange struct {
+	BaseModel
+	Type                  string   `gorm:"type:varchar(50);not null;uniqueIndex:idx_breed_reference_ranges_type_breed" json:"type"`
+	Breed                 string   `gorm:"type:varchar(50);not null;uniqueIndex:idx_breed_reference_ranges_type_breed" json:"breed"`
+	MinWeightKg           *float64 `json:"min_weight_kg"`
+	MaxWeightKg           *float64 `json:"max_weight_kg"`
+	MinHeightCm           *float64 `json:"min_height_cm"`
+	MaxHeightCm           *float64 `json:"max_height_cm"`
+	MinBodyConditionScore int      `gorm:"default:4" json:"min_body_condition_score"`
+	MaxBodyConditionScore int      `gorm:"default:5" json:"max_body_condition_score"`
+}
+
+func (BreedReferenceRange) TableName() string {
+	return "breed_reference_ranges"
+}
+
+// Species model (catalog of pet types)
+type Species struct {
+	BaseModel
+	Code      string `gorm:"type:varchar(50);not null;uniqueIndex" json:"code"`
+	Name      string `gorm:"type:varchar(50);not null" json:"name"`
+	SortOrder int    `gorm:"default:0" json:"sort_order"`
+}
+
+func (Species) TableName() string {
+	return "species"
+}
+
+// Breed model (catalog of breeds per species)
+type Breed struct {
+	BaseModel
+	Code        string `gorm:"type:varchar(100);not null;uniqueIndex" json:"code"`
+	SpeciesCode string `gorm:"type:varchar(50);not null;index" json:"species_code"`
+	Name        string `gorm:"type:varchar(50);not null" json:"name"`
+}
+
+func (Breed) TableName() string {
+	return "breeds"
+}
+
+// CatalogTerm model (localized names and aliases of species and breeds, used for matching)
+type CatalogTerm struct {
+	BaseModel
+	EntityType string `gorm:"type:varchar(10);not null;uniqueIndex:idx_catalog_terms_entity_term" json:"entity_type"`
+	Code       string `gorm:"type:varchar(100);not null;uniqueIndex:idx_catalog_terms_entity_term" json:"code"`
+	Locale     string `gorm:"type:varchar(10)" json:"locale"`
+	Term       string `gorm:"type:varchar(100);not null" json:"term"`
+	Normalized string `gorm:"type:varchar(100);not null;index;uniqueIndex:idx_catalog_terms_entity_term" json:"normalized"`
+	IsAlias    bool   `gorm:"default:false" json:"is_alias"`
+}
+
+func (CatalogTerm) TableName() string {
+	return "catalog_terms"
+}
+
 // Service model
 type Service struct {
 	BaseModel
@@ -241,3 +448,186 @@ type TokenBlacklist struct {
 func (TokenBlacklist) TableName() string {
 	return "token_blacklist"
 }
+
+// PetFollow model
+type PetFollow struct {
+	BaseModel
+	UserID string `gorm:"type:varchar(36);not null;uniqueIndex:idx_pet_follows_user_pet" json:"user_id"`
+	PetID  string `gorm:"type:varchar(36);not null;uniqueIndex:idx_pet_follows_user_pet;index" json:"pet_id"`
+	Status string `gorm:"type:varchar(20);not null;default:approved" json:"status"`
+	Pet    Pet    `gorm:"foreignKey:PetID" json:"pet,omitempty"`
+}
+
+func (PetFollow) TableName() string {
+	return "pet_follows"
+}
+
+// PetActivity model (one row per feed-worthy event, read by followers via idx_pet_activities_pet_occurred)
+type PetActivity struct {
+	BaseModel
+	PetID      string    `gorm:"type:varchar(36);not null;index:idx_pet_activities_pet_occurred,priority:1" json:"pet_id"`
+	Kind       string    `gorm:"type:varchar(20);not null" json:"kind"`
+	RefID      string    `gorm:"type:varchar(36);not null" json:"ref_id"`
+	Summary    string    `gorm:"type:varchar(255)" json:"summary"`
+	OccurredAt time.Time `gorm:"not null;index:idx_pet_activities_pet_occurred,priority:2" json:"occurred_at"`
+	Pet        Pet       `gorm:"foreignKey:PetID" json:"pet,omitempty"`
+}
+
+func (PetActivity) TableName() string {
+	return "pet_activities"
+}
+
+// PetShareLink model (unguessable public slug, e.g. printed as a QR tag)
+type PetShareLink struct {
+	BaseModel
+	PetID     string     `gorm:"type:varchar(36);not null;index" json:"pet_id"`
+	Slug      string     `gorm:"type:varchar(32);not null;uniqueIndex" json:"slug"`
+	RevokedAt *time.Time `json:"revoked_at"`
+	Pet       Pet        `gorm:"foreignKey:PetID" json:"pet,omitempty"`
+}
+
+func (PetShareLink) TableName() string {
+	return "pet_share_links"
+}
+
+// PetTribute model (message left on a memorial profile)
+type PetTribute struct {
+	BaseModel
+	PetID   string `gorm:"type:varchar(36);not null;index" json:"pet_id"`
+	UserID  string `gorm:"type:varchar(36);not null" json:"user_id"`
+	Message string `gorm:"type:text;not null" json:"message"`
+	Pet     Pet    `gorm:"foreignKey:PetID" json:"pet,omitempty"`
+	User    User   `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+func (PetTribute) TableName() string {
+	return "pet_tributes"
+}
+
+// PetExport model (background pet data export; the archive is kept in storage until ExpiresAt)
+type PetExport struct {
+	BaseModel
+	PetID       string     `gorm:"type:varchar(36);not null;index" json:"pet_id"`
+	UserID      string     `gorm:"type:varchar(36);not null;index" json:"user_id"`
+	Status      string     `gorm:"type:varchar(20);not null;default:pending" json:"status"`
+	FileName    string     `gorm:"type:varchar(255)" json:"file_name"`
+	ObjectName  string     `gorm:"type:varchar(255)" json:"object_name"`
+	SizeBytes   int64      `json:"size_bytes"`
+	Error       string     `gorm:"type:text" json:"error"`
+	CompletedAt *time.Time `json:"completed_at"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	Pet         Pet        `gorm:"foreignKey:PetID" json:"pet,omitempty"`
+}
+
+func (PetExport) TableName() string {
+	return "pet_exports"
+}
+
+// PetImport model (background bulk import; Report holds the per-row results as JSON)
+type PetImport struct {
+	BaseModel
+	UserID        string     `gorm:"type:varchar(36);not null;index" json:"user_id"`
+	FileName      string     `gorm:"type:varchar(255)" json:"file_name"`
+	Format        string     `gorm:"type:varchar(10);not null" json:"format"`
+	Status        string     `gorm:"type:varchar(20);not null;default:pending" json:"status"`
+	DryRun        bool       `json:"dry_run"`
+	FetchAvatars  bool       `json:"fetch_avatars"`
+	TotalRows     int        `json:"total_rows"`
+	ProcessedRows int        `json:"processed_rows"`
+	CreatedCount  int        `json:"created_count"`
+	ExistingCount int        `json:"existing_count"`
+	FailedCount   int        `json:"failed_count"`
+	Report        string     `gorm:"type:text" json:"report"`
+	Error         string     `gorm:"type:text" json:"error"`
+	CompletedAt   *time.Time `json:"completed_at"`
+}
+
+func (PetImport) TableName() string {
+	return "pet_imports"
+}
+
+// PetMember model (co-owner invite addressed by email; UserID is set once the user is known)
+type PetMember struct {
+	BaseModel
+	PetID       string     `gorm:"type:varchar(36);not null;index" json:"pet_id"`
+	UserID      *string    `gorm:"type:varchar(36);index" json:"user_id"`
+	Email       string     `gorm:"type:varchar(255);not null;index" json:"email"`
+	Role        string     `gorm:"type:varchar(20);not null" json:"role"`
+	Status      string     `gorm:"type:varchar(20);not null;default:pending" json:"status"`
+	RespondedAt *time.Time `json:"responded_at"`
+	Pet         Pet        `gorm:"foreignKey:PetID" json:"pet,omitempty"`
+	User        *User      `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+func (PetMember) TableName() string {
+	return "pet_members"
+}
+
+// PetTransfer model (ownership handover the recipient must accept)
+type PetTransfer struct {
+	BaseModel
+	PetID             string     `gorm:"type:varchar(36);not null;index" json:"pet_id"`
+	FromUserID        string     `gorm:"type:varchar(36);not null" json:"from_user_id"`
+	ToEmail           string     `gorm:"type:varchar(255);not null;index" json:"to_email"`
+	ToUserID          *string    `gorm:"type:varchar(36)" json:"to_user_id"`
+	Status            string     `gorm:"type:varchar(20);not null;default:pending" json:"status"`
+	PreviousOwnerRole string     `gorm:"type:varchar(20)" json:"previous_owner_role"` // role the sender keeps, empty for none
+	RespondedAt       *time.Time `json:"responded_at"`
+	Pet               Pet        `gorm:"foreignKey:PetID" json:"pet,omitempty"`
+	FromUser          User       `gorm:"foreignKey:FromUserID" json:"from_user,omitempty"`
+}
+
+func (PetTransfer) TableName() string {
+	return "pet_transfers"
+}
+
+// PetSighting model (reported by anyone, including anonymous users, for a lost pet)
+type PetSighting struct {
+	BaseModel
+	PetID           string        `gorm:"type:varchar(36);not null;index" json:"pet_id"`
+	ReporterID      string        `gorm:"type:varchar(36)" json:"reporter_id"`
+	ReporterName    string        `gorm:"type:varchar(105)" json:"reporter_name"`
+	ReporterContact string        `gorm:"type:varchar(105)" json:"reporter_contact"`
+	Note            string        `gorm:"type:text" json:"note"`
+	SeenAt          time.Time     `gorm:"not null" json:"seen_at"`
+	Location        string        `gorm:"type:varchar(255)" json:"location"`
+	Latitude        *float64      `json:"latitude"`
+	Longitude       *float64      `json:"longitude"`
+	PhotoKey        string        `gorm:"column:photo_url;type:varchar(255)" json:"photo_key"`
+	PhotoVariants   ImageVariants `gorm:"type:text;serializer:json" json:"photo_variants"`
+	Pet             Pet           `gorm:"foreignKey:PetID" json:"pet,omitempty"`
+}
+
+func (PetSighting) TableName() string {
+	return "pet_sightings"
+}
+
+// Notification model (in-app notifications)
+type Notification struct {
+	BaseModel
+	UserID string     `gorm:"type:varchar(36);not null;index" json:"user_id"`
+	Type   string     `gorm:"type:varchar(50);not null" json:"type"`
+	Title  string     `gorm:"type:varchar(255);not null" json:"title"`
+	Body   string     `gorm:"type:text" json:"body"`
+	Link   string     `gorm:"type:varchar(255)" json:"link"`
+	ReadAt *time.Time `json:"read_at"`
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+// ReminderDelivery records that a due-date reminder was sent for a record,
+// one row per record, reminder window and due date
+type ReminderDelivery struct {
+	BaseModel
+	RecordType string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_reminder_deliveries_record" json:"record_type"`
+	RecordID   string    `gorm:"type:varchar(36);not null;uniqueIndex:idx_reminder_deliveries_record" json:"record_id"`
+	WindowDays int       `gorm:"not null;uniqueIndex:idx_reminder_deliveries_record" json:"window_days"`
+	DueDate    time.Time `gorm:"type:date;not null;uniqueIndex:idx_reminder_deliveries_record" json:"due_date"`
+	UserID     string    `gorm:"type:varchar(36);not null" json:"user_id"`
+}
+
+func (ReminderDelivery) TableName() string {
+	return "reminder_deliveries"
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"pet-service/models"
+	"pet-service/utils"
+
+	"gorm.io/gorm"
+)
+
+type CatalogRepository struct {
+	DB *gorm.DB
+}
+
+func NewCatalogRepository(db *gorm.DB) *CatalogRepository {
+	return &CatalogRepository{DB: db}
+}
+
+// Species
+func (r *CatalogRepository) GetAllSpecies() ([]models.Species, error) {
+	var species []models.Species
+	err := r.DB.Where("is_active = ?", true).Order("sort_order ASC, name ASC").Find(&species).Error
+	return species, err
+}
+
+func (r *CatalogRepository) GetSpeciesByCode(code string) (*models.Species, error) {
+	var species models.Species
+	err := r.DB.Where("code = ? AND is_active = ?", code, true).First(&species).Error
+	if err != nil {
+		return nil, err
+	}
+	return &species, nil
+}
+
+func (r *CatalogRepository) GetSpeciesByCodes(codes []string) ([]models.Species, error) {
+	var species []models.Species
+	err := r.DB.Where("code IN ? AND is_active = ?", codes, true).Find(&species).Error
+	return species, err
+}
+
+func (r *CatalogRepository) CreateSpecies(species *models.Species) error {
+	return r.DB.Create(species).Error
+}
+
+// Breeds
+func (r *CatalogRepository) GetBreedByCode(code string) (*models.Breed, error) {
+	var breed models.Breed
+	err := r.DB.Where("code = ? AND is_active = ?", code, true).First(&breed).Error
+	if err != nil {
+		return nil, err
+	}
+	return &breed, nil
+}
+
+func (r *CatalogRepository) GetBreedsBySpecies(speciesCode string) ([]models.Breed, error) {
+	var breeds []models.Breed
+	err := r.DB.Where("species_code = ? AND is_active = ?", speciesCode, true).Order("name ASC").Find(&breeds).Error
+	return breeds, err
+}
+
+func (r *CatalogRepository) GetBreedsByCodes(codes []string) ([]models.Breed, error) {
+	var breeds []models.Breed
+	err := r.DB.Where("code IN ? AND is_active = ?", codes, true).Find(&breeds).Error
+	return breeds, err
+}
+
+func (r *CatalogRepository) CreateBreed(breed *models.Breed) error {
+	return r.DB.Create(breed).Error
+}
+
+// Terms
+func (r *CatalogRepository) CreateTerm(term *models.CatalogTerm) error {
+	return r.DB.Create(term).Error
+}
+
+// FindCodesByTerm returns the codes whose name or alias normalizes to the given value.
+// For breeds, speciesCode limits the match to that species.
+func (r *CatalogRepository) FindCodesByTerm(entityType, normalized, speciesCode string) ([]string, error) {
+	var codes []string
+
+	query := r.DB.Model(&models.CatalogTerm{}).
+		Where("catalog_terms.entity_type = ? AND catalog_terms.normalized = ? AND catalog_terms.is_active = ?", entityType, normalized, true)
+	if entityType == utils.CatalogEntityBreed && speciesCode != "" {
+		query = query.Joins("JOIN breeds ON breeds.code = catalog_terms.code AND breeds.is_active = true").
+			Where("breeds.species_code = ?", speciesCode)
+	}
+
+	err := query.Distinct().Pluck("catalog_terms.code", &codes).Error
+	return codes, err
+}
+
+// SuggestTerms returns terms where a word starts with prefix, shortest first
+func (r *CatalogRepository) SuggestTerms(entityType, speciesCode, prefix string, limit int) ([]models.CatalogTerm, error) {
+	var terms []models.CatalogTerm
+
+	query := r.DB.Where("catalog_terms.entity_type = ? AND catalog_terms.is_active = ?", entityType, true)
+	if prefix != "" {
+		query = query.Where("catalog_terms.normalized LIKE ? OR catalog_terms.normalized LIKE ?", prefix+"%", "% "+prefix+"%")
+	}
+	if entityType == utils.CatalogEntityBreed && speciesCode != "" {
+		query = query.Joins("JOIN breeds ON breeds.code = catalog_terms.code AND breeds.is_active = true").
+			Where("breeds.species_code = ?", speciesCode)
+	}
+
+	err := query.Order("LENGTH(catalog_terms.normalized) ASC, catalog_terms.normalized ASC").Limit(limit).Find(&terms).Error
+	return terms, err
+}
+
+// GetLocalizedNames maps codes to their name in the given locale, where one exists
+func (r *CatalogRepository) GetLocalizedNames(entityType string, codes []string, locale string) (map[string]string, error) {
+	var terms []models.CatalogTerm
+	err := r.DB.Where("entity_type = ? AND code IN ? AND locale = ? AND is_alias = ? AND is_active = ?",
+		entityType, codes, locale, false, true).
+		Find(&terms).Error
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string, len(terms))
+	for _, t := range terms {
+		names[t.Code] = t.Term
+	}
+	return names, nil
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"pet-service/models"
+	"pet-service/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type FeedRepository struct {
+	DB *gorm.DB
+}
+
+func NewFeedRepository(db *gorm.DB) *FeedRepository {
+	return &FeedRepository{DB: db}
+}
+
+// Follows
+func (r *FeedRepository) GetFollow(userID, petID string) (*models.PetFollow, error) {
+	var follow models.PetFollow
+	err := r.DB.Where("user_id = ? AND pet_id = ?", userID, petID).First(&follow).Error
+	if err != nil {
+		return nil, err
+	}
+	return &follow, nil
+}
+
+func (r *FeedRepository) CreateFollow(follow *models.PetFollow) error {
+	return r.DB.Create(follow).Error
+}
+
+func (r *FeedRepository) UpdateFollow(follow *models.PetFollow) error {
+	return r.DB.Save(follow).Error
+}
+
+func (r *FeedRepository) CountFollowers(petID string) (int64, error) {
+	var count int64
+	err := r.DB.Model(&models.PetFollow{}).
+		Where("pet_id = ? AND status = ? AND is_active = ?", petID, utils.FollowStatusApproved, true).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *FeedRepository) IsFollowing(userID, petID string) bool {
+	var count int64
+	r.DB.Model(&models.PetFollow{}).
+		Where("user_id = ? AND pet_id = ? AND status = ? AND is_active = ?", userID, petID, utils.FollowStatusApproved, true).
+		Count(&count)
+	return count > 0
+}
+
+// GetFollowedPetIDs returns the subset of petIDs the user follows with an approved follow
+func (r *FeedRepository) GetFollowedPetIDs(userID string, petIDs []string) ([]string, error) {
+	var followed []string
+	if len(petIDs) == 0 {
+		return followed, nil
+	}
+
+	err := r.DB.Model(&models.PetFollow{}).
+		Where("user_id = ? AND pet_id IN ? AND status = ? AND is_active = ?", userID, petIDs, utils.FollowStatusApproved, true).
+		Pluck("pet_id", &followed).Error
+	return followed, err
+}
+
+func (r *FeedRepository) GetPendingFollows(petID string) ([]map[string]interface{}, error) {
+	var results []map[string]interface{}
+
+	err := r.DB.Table("pet_follows").
+		Select("pet_follows.user_id, pet_follows.created_at, pet_follows.updated_at, users.first_name, users.last_name, users.avatar_url").
+		Joins("JOIN users ON users.id = pet_follows.user_id").
+		Where("pet_follows.pet_id = ? AND pet_follows.status = ? AND pet_follows.is_active = ?", petID, utils.FollowStatusPending, true).
+		Order("pet_follows.created_at ASC").
+		Scan(&results).Error
+
+	return results, err
+}
+
+// Activities
+func (r *FeedRepository) CreateActivity(activity *models.PetActivity) error {
+	return r.DB.Create(activity).Error
+}
+
+// GetFeed reads the newest activities of the pets a user follows (fan-out on read).
+// When before is set only rows strictly older than (before, beforeID) are returned.
+func (r *FeedRepository) GetFeed(userID string, before *time.Time, beforeID string, limit int) ([]map[string]interface{}, error) {
+	var results []map[string]interface{}
+
+	query := r.DB.Table("pet_activities").
+		Select(`pet_activities.id, pet_activities.kind, pet_activities.ref_id, pet_activities.summary,
+				pet_activities.occurred_at, pet_activities.created_by as actor_id,
+				pets.id as pet_id, pets.name as pet_name, pets.avt_url as pet_avt_url, pets.visibility as pet_visibility,
+				users.first_name as actor_first_name, users.last_name as actor_last_name,
+				medias.url as media_url`).
+		Joins("JOIN pet_follows ON pet_follows.pet_id = pet_activities.pet_id AND pet_follows.user_id = ? AND pet_follows.status = ? AND pet_follows.is_active = true", userID, utils.FollowStatusApproved).
+		Joins(`JOIN pets ON pets.id = pet_activities.pet_id AND pets.is_active = true AND (pets.visibility <> ? OR pets.user_id = ? OR EXISTS (
+				SELECT 1 FROM pet_members WHERE pet_members.pet_id = pets.id AND pet_members.user_id = ?
+				AND pet_members.status = ? AND pet_members.is_active = true))`,
+			utils.VisibilityPrivate, userID, userID, utils.PetInviteAccepted).
+		Joins("LEFT JOIN users ON users.id = pet_activities.created_by").
+		Joins("LEFT JOIN medias ON pet_activities.kind = 'media' AND medias.id = pet_activities.ref_id AND medias.is_active = true").
+		Where("pet_activities.is_active = ?", true)
+
+	if before != nil {
+		query = query.Where("(pet_activities.occurred_at, pet_activities.id) < (?, ?)", *before, beforeID)
+	}
+
+	err := query.Order("pet_activities.occurred_at DESC, pet_activities.id DESC").
+		Limit(limit).
+		Scan(&results).Error
+
+	return results, err
+}
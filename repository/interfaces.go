@@ -2,6 +2,7 @@ package repository
 
 import (
 	"pet-service/models"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -12,7 +13,7 @@ type IUserRepository interface {
 	CreateUser(user *models.User) error
 	GetUserByEmail(email string) (*models.User, error)
 	GetUserByID(id string) (*models.User, error)
-	GetUsers() ([]models.User, error)
+	GetUsers(page PageRequest) ([]models.User, PageInfo, error)
 	UpdateUser(user *models.User) error
 
 	// Role operations
@@ -35,6 +36,7 @@ type IUserRepository interface {
 	GetCommentByID(id string) (*models.Comment, error)
 	UpdateComment(comment *models.Comment) error
 	GetCommentsByPetID(petID string) ([]map[string]interface{}, error)
+	GetCommentPage(petID string, page PageRequest) ([]map[string]interface{}, PageInfo, error)
 }
 
 // PetRepository defines the interface for pet data access operations
@@ -42,13 +44,206 @@ type IPetRepository interface {
 	// Pet operations
 	CreatePet(pet *models.Pet) error
 	GetPetByID(id string) (*models.Pet, error)
+	GetPetWithOwner(id string) (*models.Pet, error)
 	GetPets(query *gorm.DB) *gorm.DB
 	UpdatePet(pet *models.Pet) error
 	GetPetDetail(petID string) ([]map[string]interface{}, error)
 
 	// Pet life event operations
 	CreateLifeEvent(event *models.PetLifeEvent) error
+	GetLifeEventsByPetID(petID string) ([]models.PetLifeEvent, error)
 
 	// Media operations
 	CreateMediaBatch(medias []models.Media) error
+	GetMediasByPetID(petID string) ([]models.Media, error)
+	UpdateMedia(media *models.Media) (bool, error)
+	GetNextMediaPosition(petID string) (int, error)
+	UpdatePetAvatar(petID, key string, variants models.ImageVariants) error
+	UpdatePetCover(petID, mediaID string) error
+	CreateMediaUpload(upload *models.MediaUpload) error
+	GetMediaUploadByID(id string) (*models.MediaUpload, error)
+	UpdateMediaUpload(upload *models.MediaUpload) error
+	GetExpiredMediaUploads(before time.Time, limit int) ([]models.MediaUpload, error)
+
+	// Memorial tribute operations
+	CreateTribute(tribute *models.PetTribute) error
+	GetTributeByID(id string) (*models.PetTribute, error)
+	UpdateTribute(tribute *models.PetTribute) error
+	GetTributesByPetID(petID string) ([]models.PetTribute, error)
+
+	// Data export operations
+	CreateExport(export *models.PetExport) error
+	GetExportByID(id string) (*models.PetExport, error)
+	UpdateExport(export *models.PetExport) error
+	FailStaleExports(before time.Time, reason string) (int64, error)
+	GetExpiredExports(before time.Time, limit int) ([]models.PetExport, error)
+
+	// Search operations
+	GetSearchMatches(ids []string, tsquery, startSel, stopSel string) ([]PetSearchRow, error)
+
+	// Bulk import operations
+	GetPetByExternalID(userID, externalID string) (*models.Pet, error)
+	CreateImportedPet(pet *models.Pet) (bool, error)
+	CreateImport(petImport *models.PetImport) error
+	GetImportByID(id string) (*models.PetImport, error)
+	UpdateImport(petImport *models.PetImport) error
+	FailStaleImports(before time.Time, reason string) (int64, error)
+
+	// Lost & found operations
+	GetLostPets(petType, area string, bounds *GeoBounds, offset, limit int) ([]models.Pet, int64, error)
+	CreateSighting(sighting *models.PetSighting) error
+	GetSightingsByPetID(petID string) ([]models.PetSighting, error)
+
+	// Measurement operations
+	CreateMeasurement(measurement *models.PetMeasurement) error
+	GetMeasurementByID(id string) (*models.PetMeasurement, error)
+	UpdateMeasurement(measurement *models.PetMeasurement) error
+	GetMeasurementsByPetID(petID string, from, to *time.Time) ([]models.PetMeasurement, error)
+	GetLatestMeasurement(petID string) (*models.PetMeasurement, error)
+	GetMeasurementSeries(petID, bucket string, from, to *time.Time) ([]MeasurementBucketRow, error)
+	GetBreedReferenceRange(petType, breed string) (*models.BreedReferenceRange, error)
+
+	// Pedigree operations
+	GetPetsByIDs(ids []string) ([]models.Pet, error)
+	GetAncestorIDs(petID string, maxDepth int) ([]string, error)
+	GetSiblings(pet *models.Pet) ([]models.Pet, error)
+	GetOffspring(petID string) ([]models.Pet, error)
+
+	// Share link operations
+	CreateShareLink(link *models.PetShareLink) error
+	GetActiveShareLink(petID string) (*models.PetShareLink, error)
+	GetShareLinkBySlug(slug string) (*models.PetShareLink, error)
+	UpdateShareLink(link *models.PetShareLink) error
+}
+
+// IMemberRepository defines the interface for co-owner and ownership transfer data access operations
+type IMemberRepository interface {
+	// Co-owner operations
+	CreateMember(member *models.PetMember) error
+	GetMemberByID(id string) (*models.PetMember, error)
+	UpdateMember(member *models.PetMember) error
+	GetMembersByPetID(petID string) ([]models.PetMember, error)
+	GetOpenMemberByEmail(petID, email string) (*models.PetMember, error)
+	GetPendingInvitesByEmail(email string) ([]models.PetMember, error)
+
+	// Ownership transfer operations
+	CreateTransfer(transfer *models.PetTransfer) error
+	GetTransferByID(id string) (*models.PetTransfer, error)
+	UpdateTransfer(transfer *models.PetTransfer) error
+	GetPendingTransferByPetID(petID string) (*models.PetTransfer, error)
+	GetPendingTransfersByEmail(email string) ([]models.PetTransfer, error)
+	AcceptTransfer(transfer *models.PetTransfer, recipientID string) error
+}
+
+// IMediaRepository defines the interface for gallery management and album data access operations
+type IMediaRepository interface {
+	// Gallery item operations
+	GetMediaByID(id string) (*models.Media, error)
+	GetMediasByIDs(ids []string) ([]models.Media, error)
+	GetMediasByContentHash(petID string, hashes []string) ([]models.Media, error)
+	UpdateMediaDetails(media *models.Media) error
+	DeleteMedia(media *models.Media) (bool, error)
+	ReorderMedias(petID string, ids []string) error
+	FailProcessingMedia(media *models.Media) (bool, bool, error)
+	FailStaleMedias(before time.Time) (int, []string, error)
+
+	// Blob operations
+	AcquireMediaBlob(blob *models.MediaBlob) error
+	MarkMediaBlobReady(blob *models.MediaBlob) error
+	ReleaseMediaBlob(hash string) (bool, error)
+	RemoveMediaBlob(hash string, removeObjects func(blob *models.MediaBlob)) error
+
+	// Album operations
+	CreateAlbum(album *models.MediaAlbum) error
+	GetAlbumByID(id string) (*models.MediaAlbum, error)
+	UpdateAlbum(album *models.MediaAlbum) error
+	GetAlbumsByPetID(petID string) ([]models.MediaAlbum, error)
+	GetAlbumMediaCounts(petID string) (map[string]int64, error)
+	DeleteAlbum(album *models.MediaAlbum) error
+}
+
+// IFeedRepository defines the interface for follow and activity feed data access operations
+type IFeedRepository interface {
+	// Follow operations
+	GetFollow(userID, petID string) (*models.PetFollow, error)
+	CreateFollow(follow *models.PetFollow) error
+	UpdateFollow(follow *models.PetFollow) error
+	CountFollowers(petID string) (int64, error)
+	IsFollowing(userID, petID string) bool
+	GetFollowedPetIDs(userID string, petIDs []string) ([]string, error)
+	GetPendingFollows(petID string) ([]map[string]interface{}, error)
+
+	// Activity operations
+	CreateActivity(activity *models.PetActivity) error
+	GetFeed(userID string, before *time.Time, beforeID string, limit int) ([]map[string]interface{}, error)
+}
+
+// INotificationRepository defines the interface for in-app notification data access operations
+type INotificationRepository interface {
+	CreateNotification(notification *models.Notification) error
+	GetNotificationByID(id string) (*models.Notification, error)
+	GetNotificationsByUserID(userID string, unreadOnly bool, limit int) ([]models.Notification, error)
+	UpdateNotification(notification *models.Notification) error
+}
+
+// IMedicalRepository defines the interface for medical record data access operations
+type IMedicalRepository interface {
+	// Vaccination operations
+	CreateVaccination(vaccination *models.Vaccination) error
+	GetVaccinationByID(id string) (*models.Vaccination, error)
+	UpdateVaccination(vaccination *models.Vaccination) error
+	GetVaccinationsByPetID(petID string) ([]models.Vaccination, error)
+
+	// Treatment operations
+	CreateTreatment(treatment *models.Treatment) error
+	GetTreatmentByID(id string) (*models.Treatment, error)
+	UpdateTreatment(treatment *models.Treatment) error
+	GetTreatmentsByPetID(petID string) ([]models.Treatment, error)
+
+	// Medication operations
+	CreateMedication(medication *models.Medication) error
+	GetMedicationByID(id string) (*models.Medication, error)
+	UpdateMedication(medication *models.Medication) error
+	GetMedicationsByPetID(petID string) ([]models.Medication, error)
+
+	// Appointment operations
+	AppointmentExists(id string) bool
+	GetAppointmentsByIDs(ids []string) ([]models.Appointment, error)
+}
+
+// IStorageRepository defines the interface for looking up stored object references
+type IStorageRepository interface {
+	GetMediaObjects() ([]models.Media, error)
+	GetMediaBlobs() ([]models.MediaBlob, error)
+	GetOpenUploadIDs(now time.Time) ([]string, error)
+	GetPetAvatars() ([]models.Pet, error)
+	GetSightingPhotos() ([]models.PetSighting, error)
+}
+
+// IReminderRepository defines the interface for due-date reminder data access operations
+type IReminderRepository interface {
+	GetDueRecords(from, to time.Time) ([]DueRecord, error)
+	ClaimReminder(delivery *models.ReminderDelivery) (bool, error)
+	ReleaseReminder(delivery *models.ReminderDelivery) error
+}
+
+// ICatalogRepository defines the interface for species/breed catalog data access operations
+type ICatalogRepository interface {
+	// Species operations
+	GetAllSpecies() ([]models.Species, error)
+	GetSpeciesByCode(code string) (*models.Species, error)
+	GetSpeciesByCodes(codes []string) ([]models.Species, error)
+	CreateSpecies(species *models.Species) error
+
+	// Breed operations
+	GetBreedByCode(code string) (*models.Breed, error)
+	GetBreedsBySpecies(speciesCode string) ([]models.Breed, error)
+	GetBreedsByCodes(codes []string) ([]models.Breed, error)
+	CreateBreed(breed *models.Breed) error
+
+	// Term operations
+	CreateTerm(term *models.CatalogTerm) error
+	FindCodesByTerm(entityType, normalized, speciesCode string) ([]string, error)
+	SuggestTerms(entityType, speciesCode, prefix string, limit int) ([]models.CatalogTerm, error)
+	GetLocalizedNames(entityType string, codes []string, locale string) (map[string]string, error)
 }
@@ -0,0 +1,328 @@
+package repository
+
+import (
+	"errors"
+	"pet-service/models"
+	"pet-service/utils"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MediaRepository struct {
+	DB *gorm.DB
+}
+
+func NewMediaRepository(db *gorm.DB) *MediaRepository {
+	return &MediaRepository{DB: db}
+}
+
+// Gallery items
+
+func (r *MediaRepository) GetMediaByID(id string) (*models.Media, error) {
+	var media models.Media
+	err := r.DB.Where("id = ? AND is_active = ?", id, true).First(&media).Error
+	if err != nil {
+		return nil, err
+	}
+	return &media, nil
+}
+
+func (r *MediaRepository) GetMediasByIDs(ids []string) ([]models.Media, error) {
+	var medias []models.Media
+	if len(ids) == 0 {
+		return medias, nil
+	}
+	err := r.DB.Where("id IN ? AND is_active = ?", ids, true).Find(&medias).Error
+	return medias, err
+}
+
+// UpdateMediaDetails saves what owners edit, leaving the processing pipeline's columns alone
+func (r *MediaRepository) UpdateMediaDetails(media *models.Media) error {
+	return r.DB.Model(media).Select("caption", "album_id", "position", "updated_at", "updated_by").Updates(media).Error
+}
+
+// GetMediasByContentHash finds the pet's gallery items, processing or ready, holding any
+// of the given files, oldest first
+func (r *MediaRepository) GetMediasByContentHash(petID string, hashes []string) ([]models.Media, error) {
+	var medias []models.Media
+	if len(hashes) == 0 {
+		return medias, nil
+	}
+	err := r.DB.Where("pet_id = ? AND content_hash IN ? AND status <> ? AND is_active = ?", petID, hashes, utils.MediaStatusFailed, true).
+		Order("created_at ASC").Find(&medias).Error
+	return medias, err
+}
+
+// DeleteMedia removes a gallery item and unsets it wherever it is used as a cover. A ready
+// item's reference to its blob is released in the same transaction; it reports whether
+// that was the blob's last one, see ReleaseMediaBlob.
+func (r *MediaRepository) DeleteMedia(media *models.Media) (bool, error) {
+	unreferenced := false
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&models.Media{}, "id = ?", media.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Pet{}).Where("cover_media_id = ?", media.ID).
+			Update("cover_media_id", "").Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.MediaAlbum{}).Where("cover_media_id = ?", media.ID).
+			Update("cover_media_id", "").Error; err != nil {
+			return err
+		}
+		if media.ContentHash == "" || media.Status != utils.MediaStatusReady {
+			return nil
+		}
+		var err error
+		unreferenced, err = releaseMediaBlob(tx, media.ContentHash)
+		return err
+	})
+	return unreferenced, err
+}
+
+// ReorderMedias gives the listed items of a pet positions 1..n in the order given.
+// It fails with gorm.ErrRecordNotFound, changing nothing, if an item isn't the pet's.
+func (r *MediaRepository) ReorderMedias(petID string, ids []string) error {
+	now := time.Now()
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			result := tx.Model(&models.Media{}).
+				Where("id = ? AND pet_id = ? AND is_active = ?", id, petID, true).
+				Updates(map[string]interface{}{"position": i + 1, "updated_at": now})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return gorm.ErrRecordNotFound
+			}
+		}
+		return nil
+	})
+}
+
+// FailProcessingMedia marks an item that is still processing failed, releasing the
+// reference to its blob a video holds meanwhile. It reports whether the item was failed,
+// and whether that released the blob's last reference, see ReleaseMediaBlob.
+func (r *MediaRepository) FailProcessingMedia(media *models.Media) (bool, bool, error) {
+	failed, unreferenced := false, false
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		failed, unreferenced, err = failProcessingMedia(tx, media)
+		return err
+	})
+	return failed, unreferenced, err
+}
+
+// FailStaleMedias fails the items processing since before, whose pipeline was lost to a
+// restart, see FailProcessingMedia. Blobs not ready since before that no item holds lost
+// their holder the same way and are released entirely. It returns how
+// many items were failed and the hashes of the blobs left without references.
+func (r *MediaRepository) FailStaleMedias(before time.Time) (int, []string, error) {
+	count := 0
+	var unreferenced []string
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var medias []models.Media
+		err := tx.Where("status = ? AND COALESCE(updated_at, created_at) < ?", utils.MediaStatusProcessing, before).
+			Find(&medias).Error
+		if err != nil {
+			return err
+		}
+		for i := range medias {
+			failed, released, err := failProcessingMedia(tx, &medias[i])
+			if err != nil {
+				return err
+			}
+			if failed {
+				count++
+			}
+			if released {
+				unreferenced = append(unreferenced, medias[i].ContentHash)
+			}
+		}
+
+		var blobs []models.MediaBlob
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("ready = ? AND ref_count > 0 AND COALESCE(updated_at, created_at) < ?", false, before).
+			Where("NOT EXISTS (SELECT 1 FROM medias WHERE medias.content_hash = media_blobs.hash AND medias.status <> ?)", utils.MediaStatusFailed).
+			Find(&blobs).Error
+		if err != nil {
+			return err
+		}
+		for _, blob := range blobs {
+			if err := tx.Model(&blob).Updates(map[string]interface{}{"ref_count": 0, "updated_at": time.Now()}).Error; err != nil {
+				return err
+			}
+			unreferenced = append(unreferenced, blob.Hash)
+		}
+		return nil
+	})
+	return count, unreferenced, err
+}
+
+func failProcessingMedia(tx *gorm.DB, media *models.Media) (bool, bool, error) {
+	now := time.Now()
+	result := tx.Model(&models.Media{}).Where("id = ? AND status = ?", media.ID, utils.MediaStatusProcessing).
+		Updates(map[string]interface{}{"status": utils.MediaStatusFailed, "updated_at": now})
+	if result.Error != nil || result.RowsAffected == 0 {
+		return false, false, result.Error
+	}
+	media.Status = utils.MediaStatusFailed
+	media.UpdatedAt = &now
+
+	if media.Type != utils.MediaTypeVideo || media.ContentHash == "" {
+		return true, false, nil
+	}
+	unreferenced, err := releaseMediaBlob(tx, media.ContentHash)
+	return true, unreferenced, err
+}
+
+// Blobs
+
+// AcquireMediaBlob adds a reference to the blob of blob.Hash, creating it under blob.Key,
+// not ready, if there is none, and loads the stored row into blob
+func (r *MediaRepository) AcquireMediaBlob(blob *models.MediaBlob) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		blob.RefCount = 1
+		blob.UpdatedAt = &now
+		err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "hash"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"ref_count":  gorm.Expr("media_blobs.ref_count + 1"),
+				"updated_at": now,
+			}),
+		}).Create(blob).Error
+		if err != nil {
+			return err
+		}
+
+		var stored models.MediaBlob
+		if err := tx.Where("hash = ?", blob.Hash).First(&stored).Error; err != nil {
+			return err
+		}
+		*blob = stored
+		return nil
+	})
+}
+
+// MarkMediaBlobReady records the objects stored for a blob
+func (r *MediaRepository) MarkMediaBlobReady(blob *models.MediaBlob) error {
+	now := time.Now()
+	blob.Ready = true
+	blob.UpdatedAt = &now
+	return r.DB.Model(&models.MediaBlob{}).Where("hash = ?", blob.Hash).
+		Select("key", "variants", "ready", "updated_at").Updates(blob).Error
+}
+
+// ReleaseMediaBlob drops a reference to the blob of hash and reports whether it was the
+// last one. The blob is then marked not ready, so nothing reuses its objects; remove them
+// with RemoveMediaBlob once this has committed.
+func (r *MediaRepository) ReleaseMediaBlob(hash string) (bool, error) {
+	unreferenced := false
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		unreferenced, err = releaseMediaBlob(tx, hash)
+		return err
+	})
+	return unreferenced, err
+}
+
+func releaseMediaBlob(tx *gorm.DB, hash string) (bool, error) {
+	var blob models.MediaBlob
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("hash = ?", hash).First(&blob).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if blob.RefCount > 1 {
+		return false, tx.Model(&blob).Update("ref_count", gorm.Expr("ref_count - 1")).Error
+	}
+	err = tx.Model(&blob).Updates(map[string]interface{}{"ref_count": 0, "ready": false, "updated_at": time.Now()}).Error
+	return err == nil, err
+}
+
+// RemoveMediaBlob deletes a blob left without references, calling removeObjects to delete
+// its objects. The row stays locked meanwhile, so an upload of the same file waits, then
+// stores the objects anew. A blob taken again since its release is left to its new holder,
+// which stores the objects itself since the blob isn't ready; if removal fails halfway the
+// blob is still not ready, so whatever is left is never handed out.
+func (r *MediaRepository) RemoveMediaBlob(hash string, removeObjects func(blob *models.MediaBlob)) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		var blob models.MediaBlob
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("hash = ?", hash).First(&blob).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if blob.RefCount > 0 {
+			return nil
+		}
+
+		removeObjects(&blob)
+		return tx.Delete(&blob).Error
+	})
+}
+
+// Albums
+
+func (r *MediaRepository) CreateAlbum(album *models.MediaAlbum) error {
+	return r.DB.Create(album).Error
+}
+
+func (r *MediaRepository) GetAlbumByID(id string) (*models.MediaAlbum, error) {
+	var album models.MediaAlbum
+	err := r.DB.Where("id = ? AND is_active = ?", id, true).First(&album).Error
+	if err != nil {
+		return nil, err
+	}
+	return &album, nil
+}
+
+func (r *MediaRepository) UpdateAlbum(album *models.MediaAlbum) error {
+	return r.DB.Save(album).Error
+}
+
+func (r *MediaRepository) GetAlbumsByPetID(petID string) ([]models.MediaAlbum, error) {
+	var albums []models.MediaAlbum
+	err := r.DB.Where("pet_id = ? AND is_active = ?", petID, true).Order("created_at ASC").Find(&albums).Error
+	return albums, err
+}
+
+// GetAlbumMediaCounts counts the gallery items of each of a pet's albums
+func (r *MediaRepository) GetAlbumMediaCounts(petID string) (map[string]int64, error) {
+	var rows []struct {
+		AlbumID string
+		Count   int64
+	}
+	err := r.DB.Model(&models.Media{}).
+		Select("album_id, COUNT(*) as count").
+		Where("pet_id = ? AND is_active = ? AND album_id IS NOT NULL", petID, true).
+		Group("album_id").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.AlbumID] = row.Count
+	}
+	return counts, nil
+}
+
+// DeleteAlbum deactivates an album; its items stay in the gallery without an album
+func (r *MediaRepository) DeleteAlbum(album *models.MediaAlbum) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Media{}).Where("album_id = ?", album.ID).
+			Update("album_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Save(album).Error
+	})
+}
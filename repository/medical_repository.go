@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"pet-service/models"
+
+	"gorm.io/gorm"
+)
+
+type MedicalRepository struct {
+	DB *gorm.DB
+}
+
+func NewMedicalRepository(db *gorm.DB) *MedicalRepository {
+	return &MedicalRepository{DB: db}
+}
+
+// Vaccinations
+func (r *MedicalRepository) CreateVaccination(vaccination *models.Vaccination) error {
+	return r.DB.Create(vaccination).Error
+}
+
+func (r *MedicalRepository) GetVaccinationByID(id string) (*models.Vaccination, error) {
+	var vaccination models.Vaccination
+	err := r.DB.Where("id = ? AND is_active = ?", id, true).First(&vaccination).Error
+	if err != nil {
+		return nil, err
+	}
+	return &vaccination, nil
+}
+
+func (r *MedicalRepository) UpdateVaccination(vaccination *models.Vaccination) error {
+	return r.DB.Save(vaccination).Error
+}
+
+func (r *MedicalRepository) GetVaccinationsByPetID(petID string) ([]models.Vaccination, error) {
+	var vaccinations []models.Vaccination
+	err := r.DB.Where("pet_id = ? AND is_active = ?", petID, true).Order("administered_at DESC").Find(&vaccinations).Error
+	return vaccinations, err
+}
+
+// Treatments
+func (r *MedicalRepository) CreateTreatment(treatment *models.Treatment) error {
+	return r.DB.Create(treatment).Error
+}
+
+func (r *MedicalRepository) GetTreatmentByID(id string) (*models.Treatment, error) {
+	var treatment models.Treatment
+	err := r.DB.Where("id = ? AND is_active = ?", id, true).First(&treatment).Error
+	if err != nil {
+		return nil, err
+	}
+	return &treatment, nil
+}
+
+func (r *MedicalRepository) UpdateTreatment(treatment *models.Treatment) error {
+	return r.DB.Save(treatment).Error
+}
+
+func (r *MedicalRepository) GetTreatmentsByPetID(petID string) ([]models.Treatment, error) {
+	var treatments []models.Treatment
+	err := r.DB.Where("pet_id = ? AND is_active = ?", petID, true).Order("treated_at DESC").Find(&treatments).Error
+	return treatments, err
+}
+
+// Medications
+func (r *MedicalRepository) CreateMedication(medication *models.Medication) error {
+	return r.DB.Create(medication).Error
+}
+
+func (r *MedicalRepository) GetMedicationByID(id string) (*models.Medication, error) {
+	var medication models.Medication
+	err := r.DB.Where("id = ? AND is_active = ?", id, true).First(&medication).Error
+	if err != nil {
+		return nil, err
+	}
+	return &medication, nil
+}
+
+func (r *MedicalRepository) UpdateMedication(medication *models.Medication) error {
+	return r.DB.Save(medication).Error
+}
+
+func (r *MedicalRepository) GetMedicationsByPetID(petID string) ([]models.Medication, error) {
+	var medications []models.Medication
+	err := r.DB.Where("pet_id = ? AND is_active = ?", petID, true).Order("start_date DESC").Find(&medications).Error
+	return medications, err
+}
+
+// Appointments
+func (r *MedicalRepository) AppointmentExists(id string) bool {
+	var count int64
+	r.DB.Model(&models.Appointment{}).Where("id = ? AND is_active = ?", id, true).Count(&count)
+	return count > 0
+}
+
+func (r *MedicalRepository) GetAppointmentsByIDs(ids []string) ([]models.Appointment, error) {
+	var appointments []models.Appointment
+	if len(ids) == 0 {
+		return appointments, nil
+	}
+	err := r.DB.Where("id IN ? AND is_active = ?", ids, true).Order("start_time ASC").Find(&appointments).Error
+	return appointments, err
+}
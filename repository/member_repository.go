@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"pet-service/models"
+	"pet-service/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type MemberRepository struct {
+	DB *gorm.DB
+}
+
+func NewMemberRepository(db *gorm.DB) *MemberRepository {
+	return &MemberRepository{DB: db}
+}
+
+// Co-owners
+
+func (r *MemberRepository) CreateMember(member *models.PetMember) error {
+	return r.DB.Create(member).Error
+}
+
+func (r *MemberRepository) GetMemberByID(id string) (*models.PetMember, error) {
+	var member models.PetMember
+	err := r.DB.Preload("User").Where("id = ? AND is_active = ?", id, true).First(&member).Error
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+func (r *MemberRepository) UpdateMember(member *models.PetMember) error {
+	return r.DB.Omit("Pet", "User").Save(member).Error
+}
+
+// GetMembersByPetID returns accepted co-owners and pending invites
+func (r *MemberRepository) GetMembersByPetID(petID string) ([]models.PetMember, error) {
+	var members []models.PetMember
+	err := r.DB.Preload("User").
+		Where("pet_id = ? AND status IN ? AND is_active = ?", petID, []string{utils.PetInvitePending, utils.PetInviteAccepted}, true).
+		Order("created_at ASC").
+		Find(&members).Error
+	return members, err
+}
+
+// GetOpenMemberByEmail finds a pending or accepted membership for the email on a pet
+func (r *MemberRepository) GetOpenMemberByEmail(petID, email string) (*models.PetMember, error) {
+	var member models.PetMember
+	err := r.DB.Where("pet_id = ? AND email = ? AND status IN ? AND is_active = ?",
+		petID, email, []string{utils.PetInvitePending, utils.PetInviteAccepted}, true).
+		First(&member).Error
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+func (r *MemberRepository) GetPendingInvitesByEmail(email string) ([]models.PetMember, error) {
+	var members []models.PetMember
+	err := r.DB.Preload("Pet").
+		Joins("JOIN pets ON pets.id = pet_members.pet_id AND pets.is_active = true").
+		Where("pet_members.email = ? AND pet_members.status = ? AND pet_members.is_active = ?", email, utils.PetInvitePending, true).
+		Order("pet_members.created_at DESC").
+		Find(&members).Error
+	return members, err
+}
+
+// Ownership transfers
+
+func (r *MemberRepository) CreateTransfer(transfer *models.PetTransfer) error {
+	return r.DB.Create(transfer).Error
+}
+
+func (r *MemberRepository) GetTransferByID(id string) (*models.PetTransfer, error) {
+	var transfer models.PetTransfer
+	err := r.DB.Where("id = ? AND is_active = ?", id, true).First(&transfer).Error
+	if err != nil {
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+func (r *MemberRepository) UpdateTransfer(transfer *models.PetTransfer) error {
+	return r.DB.Omit("Pet", "FromUser").Save(transfer).Error
+}
+
+func (r *MemberRepository) GetPendingTransferByPetID(petID string) (*models.PetTransfer, error) {
+	var transfer models.PetTransfer
+	err := r.DB.Where("pet_id = ? AND status = ? AND is_active = ?", petID, utils.PetInvitePending, true).
+		First(&transfer).Error
+	if err != nil {
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+func (r *MemberRepository) GetPendingTransfersByEmail(email string) ([]models.PetTransfer, error) {
+	var transfers []models.PetTransfer
+	err := r.DB.Preload("Pet").Preload("FromUser").
+		Joins("JOIN pets ON pets.id = pet_transfers.pet_id AND pets.is_active = true").
+		Where("pet_transfers.to_email = ? AND pet_transfers.status = ? AND pet_transfers.is_active = ?", email, utils.PetInvitePending, true).
+		Order("pet_transfers.created_at DESC").
+		Find(&transfers).Error
+	return transfers, err
+}
+
+// AcceptTransfer hands the pet to the recipient in one transaction. Life events,
+// media and medical records reference the pet, so they move with it. The
+// recipient's own co-owner row is closed, and the sender optionally stays on as
+// a co-owner with the role chosen when the transfer was created.
+func (r *MemberRepository) AcceptTransfer(transfer *models.PetTransfer, recipientID string) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		if err := tx.Model(&models.Pet{}).Where("id = ?", transfer.PetID).
+			Updates(map[string]interface{}{"user_id": recipientID, "updated_at": now, "updated_by": recipientID}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.PetMember{}).
+			Where("pet_id = ? AND (user_id = ? OR email = ?) AND status IN ? AND is_active = ?",
+				transfer.PetID, recipientID, transfer.ToEmail, []string{utils.PetInvitePending, utils.PetInviteAccepted}, true).
+			Updates(map[string]interface{}{"is_active": false, "updated_at": now, "updated_by": recipientID}).Error; err != nil {
+			return err
+		}
+
+		if transfer.PreviousOwnerRole != "" {
+			var sender models.User
+			if err := tx.Where("id = ?", transfer.FromUserID).First(&sender).Error; err != nil {
+				return err
+			}
+			member := &models.PetMember{
+				PetID:       transfer.PetID,
+				UserID:      &sender.ID,
+				Email:       utils.NormalizeEmail(sender.Email),
+				Role:        transfer.PreviousOwnerRole,
+				Status:      utils.PetInviteAccepted,
+				RespondedAt: &now,
+			}
+			member.CreatedBy = recipientID
+			if err := tx.Create(member).Error; err != nil {
+				return err
+			}
+		}
+
+		transfer.Status = utils.PetInviteAccepted
+		transfer.ToUserID = &recipientID
+		transfer.RespondedAt = &now
+		transfer.UpdatedAt = &now
+		transfer.UpdatedBy = recipientID
+		return tx.Omit("Pet", "FromUser").Save(transfer).Error
+	})
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"pet-service/models"
+
+	"gorm.io/gorm"
+)
+
+type NotificationRepository struct {
+	DB *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) *NotificationRepository {
+	return &NotificationRepository{DB: db}
+}
+
+func (r *NotificationRepository) CreateNotification(notification *models.Notification) error {
+	return r.DB.Create(notification).Error
+}
+
+func (r *NotificationRepository) GetNotificationByID(id string) (*models.Notification, error) {
+	var notification models.Notification
+	err := r.DB.Where("id = ? AND is_active = ?", id, true).First(&notification).Error
+	if err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
+
+func (r *NotificationRepository) GetNotificationsByUserID(userID string, unreadOnly bool, limit int) ([]models.Notification, error) {
+	var notifications []models.Notification
+
+	query := r.DB.Where("user_id = ? AND is_active = ?", userID, true)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	err := query.Order("created_at DESC").Limit(limit).Find(&notifications).Error
+	return notifications, err
+}
+
+func (r *NotificationRepository) UpdateNotification(notification *models.Notification) error {
+	return r.DB.Save(notification).Error
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"pet-service/utils"
+
+	"gorm.io/gorm"
+)
+
+// PageRequest selects one page of a list, either by offset or by keyset on (created_at, id)
+type PageRequest struct {
+	Page       int // 1-based, offset mode only
+	Size       int
+	Keyset     bool
+	Cursor     *utils.PageCursor // keyset position; nil for the first page
+	CountTotal bool
+}
+
+// PageInfo describes the page returned by Paginate
+type PageInfo struct {
+	Total   *int64 // only set when CountTotal was requested
+	HasMore bool   // keyset mode: another page exists in the direction of travel
+}
+
+// Paginate runs query for one page. Offset mode uses offsetOrder; keyset mode always
+// lists newest first by createdAtCol, idCol and fetches one extra row to detect
+// another page. Rows are returned newest first in both modes.
+func Paginate[T any](query *gorm.DB, page PageRequest, createdAtCol, idCol string, offsetOrder interface{}) ([]T, PageInfo, error) {
+	var info PageInfo
+	query = query.Session(&gorm.Session{})
+
+	if page.CountTotal {
+		var total int64
+		if err := query.Count(&total).Error; err != nil {
+			return nil, info, err
+		}
+		info.Total = &total
+	}
+
+	var rows []T
+	if !page.Keyset {
+		err := query.Order(offsetOrder).Limit(page.Size).Offset((page.Page - 1) * page.Size).Find(&rows).Error
+		return rows, info, err
+	}
+
+	switch {
+	case page.Cursor == nil:
+		query = query.Order(createdAtCol + " DESC, " + idCol + " DESC")
+	case page.Cursor.Backward:
+		query = query.Where("("+createdAtCol+", "+idCol+") > (?, ?)", page.Cursor.CreatedAt, page.Cursor.ID).
+			Order(createdAtCol + " ASC, " + idCol + " ASC")
+	default:
+		query = query.Where("("+createdAtCol+", "+idCol+") < (?, ?)", page.Cursor.CreatedAt, page.Cursor.ID).
+			Order(createdAtCol + " DESC, " + idCol + " DESC")
+	}
+
+	if err := query.Limit(page.Size + 1).Find(&rows).Error; err != nil {
+		return nil, info, err
+	}
+
+	info.HasMore = len(rows) > page.Size
+	if info.HasMore {
+		rows = rows[:page.Size]
+	}
+	if page.Cursor != nil && page.Cursor.Backward {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+
+	return rows, info, nil
+}
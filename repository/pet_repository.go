@@ -1,9 +1,14 @@
 package repository
 
 import (
+	"errors"
+	"fmt"
 	"pet-service/models"
+	"pet-service/utils"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PetRepository struct {
@@ -14,13 +19,47 @@ func NewPetRepository(db *gorm.DB) *PetRepository {
 	return &PetRepository{DB: db}
 }
 
+// withMembers preloads accepted co-owners, which pet access checks rely on
+func withMembers(db *gorm.DB) *gorm.DB {
+	return db.Preload("Members", "status = ? AND is_active = ?", utils.PetInviteAccepted, true)
+}
+
 func (r *PetRepository) CreatePet(pet *models.Pet) error {
-	return r.DB.Create(pet).Error
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(pet).Error; err != nil {
+			return err
+		}
+		// gender has a column default of true, so Create skips a false value
+		if !pet.Gender {
+			return tx.Model(pet).UpdateColumn("gender", false).Error
+		}
+		return nil
+	})
+}
+
+// CreateImportedPet stores a pet from a bulk import and reports whether it was created.
+// The unique index on (user_id, external_id) turns away a row the user already imported,
+// even by an import running at the same time.
+func (r *PetRepository) CreateImportedPet(pet *models.Pet) (bool, error) {
+	err := r.CreatePet(pet)
+	if isDuplicateKey(r.DB, err) {
+		return false, nil
+	}
+	return err == nil, err
 }
 
 func (r *PetRepository) GetPetByID(id string) (*models.Pet, error) {
 	var pet models.Pet
-	err := r.DB.Where("id = ? AND is_active = ?", id, true).First(&pet).Error
+	err := withMembers(r.DB).Where("id = ? AND is_active = ?", id, true).First(&pet).Error
+	if err != nil {
+		return nil, err
+	}
+	return &pet, nil
+}
+
+func (r *PetRepository) GetPetWithOwner(id string) (*models.Pet, error) {
+	var pet models.Pet
+	err := withMembers(r.DB).Preload("User").Where("id = ? AND is_active = ?", id, true).First(&pet).Error
 	if err != nil {
 		return nil, err
 	}
@@ -32,7 +71,37 @@ func (r *PetRepository) GetPets(query *gorm.DB) *gorm.DB {
 }
 
 func (r *PetRepository) UpdatePet(pet *models.Pet) error {
-	return r.DB.Save(pet).Error
+	return r.DB.Omit(clause.Associations).Save(pet).Error
+}
+
+// PetSearchRow is a pet's full-text rank and highlighted fields for a search
+type PetSearchRow struct {
+	ID          string
+	Rank        float64
+	Name        string
+	Breed       string
+	Description string
+}
+
+// GetSearchMatches ranks and highlights the given pets against a to_tsquery expression.
+// Matched words are wrapped in startSel/stopSel.
+func (r *PetRepository) GetSearchMatches(ids []string, tsquery, startSel, stopSel string) ([]PetSearchRow, error) {
+	var rows []PetSearchRow
+	if len(ids) == 0 {
+		return rows, nil
+	}
+
+	fieldOptions := fmt.Sprintf("StartSel=%s, StopSel=%s, HighlightAll=true", startSel, stopSel)
+	snippetOptions := fmt.Sprintf("StartSel=%s, StopSel=%s, MaxWords=20, MinWords=8, MaxFragments=2", startSel, stopSel)
+	err := r.DB.Raw(`SELECT id,
+			ts_rank(search_vector, q) AS rank,
+			ts_headline('simple', coalesce(name, ''), q, ?) AS name,
+			ts_headline('simple', coalesce(breed, ''), q, ?) AS breed,
+			ts_headline('simple', coalesce(description, ''), q, ?) AS description
+		FROM pets, to_tsquery('simple', ?) AS q
+		WHERE id IN ?`, fieldOptions, fieldOptions, snippetOptions, tsquery, ids).
+		Scan(&rows).Error
+	return rows, err
 }
 
 // Pet Life Events
@@ -40,6 +109,12 @@ func (r *PetRepository) CreateLifeEvent(event *models.PetLifeEvent) error {
 	return r.DB.Create(event).Error
 }
 
+func (r *PetRepository) GetLifeEventsByPetID(petID string) ([]models.PetLifeEvent, error) {
+	var events []models.PetLifeEvent
+	err := r.DB.Where("pet_id = ? AND is_active = ?", petID, true).Order("date ASC").Find(&events).Error
+	return events, err
+}
+
 func (r *PetRepository) GetPetDetail(petID string) ([]map[string]interface{}, error) {
 	var results []map[string]interface{}
 
@@ -50,16 +125,421 @@ func (r *PetRepository) GetPetDetail(petID string) ([]map[string]interface{}, er
 				pet_life_events.id as event_id, pet_life_events.title as event_title, 
 				pet_life_events.date as event_date, pet_life_events.location as event_location, 
 				pet_life_events.story as event_story,
-				medias.id as media_id, medias.url as media_url`).
+				medias.id as media_id, medias.url as media_url, medias.type as media_type,
+				medias.status as media_status, medias.variants as media_variants,
+				medias.duration as media_duration, medias.width as media_width, medias.height as media_height,
+				medias.caption as media_caption, medias.album_id as media_album_id`).
 		Joins("LEFT JOIN pet_life_events ON pet_life_events.pet_id = pets.id AND pet_life_events.is_active = true").
-		Joins("LEFT JOIN medias ON medias.pet_id = pets.id AND medias.is_active = true").
+		Joins("LEFT JOIN medias ON medias.pet_id = pets.id AND medias.is_active = true AND medias.status <> ?", utils.MediaStatusFailed).
 		Where("pets.id = ? AND pets.is_active = ?", petID, true).
+		Order("medias.position ASC, medias.created_at ASC, pet_life_events.date ASC").
 		Scan(&results).Error
 
 	return results, err
 }
 
+// Lost & found
+
+// GeoBounds is a latitude/longitude bounding box used for area searches
+type GeoBounds struct {
+	MinLat float64
+	MaxLat float64
+	MinLng float64
+	MaxLng float64
+}
+
+func (r *PetRepository) GetLostPets(petType, area string, bounds *GeoBounds, offset, limit int) ([]models.Pet, int64, error) {
+	query := r.DB.Model(&models.Pet{}).Where("is_lost = ? AND is_active = ? AND date_of_death IS NULL", true, true)
+
+	if petType != "" {
+		query = query.Where("LOWER(type) = LOWER(?)", petType)
+	}
+	if area != "" {
+		query = query.Where("last_seen_location ILIKE ?", "%"+area+"%")
+	}
+	if bounds != nil {
+		query = query.Where("last_seen_lat BETWEEN ? AND ? AND last_seen_lng BETWEEN ? AND ?",
+			bounds.MinLat, bounds.MaxLat, bounds.MinLng, bounds.MaxLng)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var pets []models.Pet
+	err := query.Order("lost_at DESC, id ASC").Offset(offset).Limit(limit).Find(&pets).Error
+	return pets, total, err
+}
+
+// Pedigree
+
+func (r *PetRepository) GetPetsByIDs(ids []string) ([]models.Pet, error) {
+	var pets []models.Pet
+	if len(ids) == 0 {
+		return pets, nil
+	}
+	err := withMembers(r.DB).Where("id IN ? AND is_active = ?", ids, true).Find(&pets).Error
+	return pets, err
+}
+
+// GetAncestorIDs returns the pet itself plus every linked ancestor up to maxDepth generations
+func (r *PetRepository) GetAncestorIDs(petID string, maxDepth int) ([]string, error) {
+	var ids []string
+	err := r.DB.Raw(`
+		WITH RECURSIVE ancestors(id, depth) AS (
+			SELECT CAST(? AS varchar), 0
+			UNION
+			SELECT parent.id, a.depth + 1
+			FROM ancestors a
+			JOIN pets p ON p.id = a.id
+			CROSS JOIN LATERAL (VALUES (p.sire_id), (p.dam_id)) AS parent(id)
+			WHERE parent.id IS NOT NULL AND a.depth < ?
+		)
+		SELECT DISTINCT id FROM ancestors`, petID, maxDepth).
+		Scan(&ids).Error
+	return ids, err
+}
+
+// GetSiblings returns pets sharing at least one linked parent with the given pet
+func (r *PetRepository) GetSiblings(pet *models.Pet) ([]models.Pet, error) {
+	var pets []models.Pet
+	if pet.SireID == nil && pet.DamID == nil {
+		return pets, nil
+	}
+
+	parents := r.DB.Where("1 = 0")
+	if pet.SireID != nil {
+		parents = parents.Or("sire_id = ?", *pet.SireID)
+	}
+	if pet.DamID != nil {
+		parents = parents.Or("dam_id = ?", *pet.DamID)
+	}
+
+	err := withMembers(r.DB).Where("id <> ? AND is_active = ?", pet.ID, true).
+		Where(parents).
+		Order("date_of_birth ASC, name ASC").
+		Find(&pets).Error
+	return pets, err
+}
+
+func (r *PetRepository) GetOffspring(petID string) ([]models.Pet, error) {
+	var pets []models.Pet
+	err := withMembers(r.DB).Where("(sire_id = ? OR dam_id = ?) AND is_active = ?", petID, petID, true).
+		Order("date_of_birth ASC, name ASC").
+		Find(&pets).Error
+	return pets, err
+}
+
+func (r *PetRepository) CreateSighting(sighting *models.PetSighting) error {
+	return r.DB.Create(sighting).Error
+}
+
+func (r *PetRepository) GetSightingsByPetID(petID string) ([]models.PetSighting, error) {
+	var sightings []models.PetSighting
+	err := r.DB.Where("pet_id = ? AND is_active = ?", petID, true).Order("seen_at DESC").Find(&sightings).Error
+	return sightings, err
+}
+
+// Measurements
+
+// MeasurementBucketRow holds min/max/avg readings for one time bucket
+type MeasurementBucketRow struct {
+	Bucket    time.Time
+	Count     int64
+	MinWeight *float64
+	MaxWeight *float64
+	AvgWeight *float64
+	MinBcs    *float64
+	MaxBcs    *float64
+	AvgBcs    *float64
+	MinHeight *float64
+	MaxHeight *float64
+	AvgHeight *float64
+}
+
+func (r *PetRepository) CreateMeasurement(measurement *models.PetMeasurement) error {
+	return r.DB.Create(measurement).Error
+}
+
+func (r *PetRepository) GetMeasurementByID(id string) (*models.PetMeasurement, error) {
+	var measurement models.PetMeasurement
+	err := r.DB.Preload("Staff").Where("id = ? AND is_active = ?", id, true).First(&measurement).Error
+	if err != nil {
+		return nil, err
+	}
+	return &measurement, nil
+}
+
+func (r *PetRepository) UpdateMeasurement(measurement *models.PetMeasurement) error {
+	return r.DB.Omit("Staff", "Pet").Save(measurement).Error
+}
+
+func (r *PetRepository) GetMeasurementsByPetID(petID string, from, to *time.Time) ([]models.PetMeasurement, error) {
+	var measurements []models.PetMeasurement
+
+	query := r.DB.Preload("Staff").Where("pet_id = ? AND is_active = ?", petID, true)
+	if from != nil {
+		query = query.Where("measured_at >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("measured_at < ?", *to)
+	}
+
+	err := query.Order("measured_at DESC").Find(&measurements).Error
+	return measurements, err
+}
+
+func (r *PetRepository) GetLatestMeasurement(petID string) (*models.PetMeasurement, error) {
+	var measurement models.PetMeasurement
+	err := r.DB.Where("pet_id = ? AND is_active = ?", petID, true).Order("measured_at DESC").First(&measurement).Error
+	if err != nil {
+		return nil, err
+	}
+	return &measurement, nil
+}
+
+// GetMeasurementSeries aggregates readings per bucket ("week" or "month")
+func (r *PetRepository) GetMeasurementSeries(petID, bucket string, from, to *time.Time) ([]MeasurementBucketRow, error) {
+	var rows []MeasurementBucketRow
+
+	query := r.DB.Model(&models.PetMeasurement{}).
+		Select(`date_trunc(?, measured_at) AS bucket, COUNT(*) AS count,
+				MIN(weight_kg) AS min_weight, MAX(weight_kg) AS max_weight, AVG(weight_kg) AS avg_weight,
+				MIN(body_condition_score) AS min_bcs, MAX(body_condition_score) AS max_bcs, AVG(body_condition_score) AS avg_bcs,
+				MIN(height_cm) AS min_height, MAX(height_cm) AS max_height, AVG(height_cm) AS avg_height`, bucket).
+		Where("pet_id = ? AND is_active = ?", petID, true)
+	if from != nil {
+		query = query.Where("measured_at >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("measured_at < ?", *to)
+	}
+
+	err := query.Group("bucket").Order("bucket").Scan(&rows).Error
+	return rows, err
+}
+
+func (r *PetRepository) GetBreedReferenceRange(petType, breed string) (*models.BreedReferenceRange, error) {
+	var reference models.BreedReferenceRange
+	err := r.DB.Where("LOWER(type) = LOWER(?) AND LOWER(breed) = LOWER(?) AND is_active = ?", petType, breed, true).
+		First(&reference).Error
+	if err != nil {
+		return nil, err
+	}
+	return &reference, nil
+}
+
+// Share links
+func (r *PetRepository) CreateShareLink(link *models.PetShareLink) error {
+	return r.DB.Create(link).Error
+}
+
+func (r *PetRepository) GetActiveShareLink(petID string) (*models.PetShareLink, error) {
+	var link models.PetShareLink
+	err := r.DB.Where("pet_id = ? AND is_active = ?", petID, true).Order("created_at DESC").First(&link).Error
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+func (r *PetRepository) GetShareLinkBySlug(slug string) (*models.PetShareLink, error) {
+	var link models.PetShareLink
+	err := r.DB.Preload("Pet").Preload("Pet.User").
+		Where("slug = ? AND is_active = ?", slug, true).First(&link).Error
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+func (r *PetRepository) UpdateShareLink(link *models.PetShareLink) error {
+	return r.DB.Save(link).Error
+}
+
 // Media
 func (r *PetRepository) CreateMediaBatch(medias []models.Media) error {
 	return r.DB.Create(&medias).Error
 }
+
+// GetMediasByPetID lists the pet's stored gallery files; photos still processing are left out
+func (r *PetRepository) GetMediasByPetID(petID string) ([]models.Media, error) {
+	var medias []models.Media
+	err := r.DB.Where("pet_id = ? AND is_active = ? AND status = ?", petID, true, utils.MediaStatusReady).
+		Order("position ASC, created_at ASC").Find(&medias).Error
+	return medias, err
+}
+
+// UpdateMedia saves what the processing pipeline produced, leaving captions, order and
+// albums edited in the meantime alone. It reports false, saving nothing, if the item is no
+// longer processing, e.g. because the job sweep failed it.
+func (r *PetRepository) UpdateMedia(media *models.Media) (bool, error) {
+	result := r.DB.Model(media).Where("status = ?", utils.MediaStatusProcessing).
+		Select("url", "variants", "status", "updated_at").Updates(media)
+	return result.RowsAffected > 0, result.Error
+}
+
+// GetNextMediaPosition returns the position that puts a new upload at the end of the gallery
+func (r *PetRepository) GetNextMediaPosition(petID string) (int, error) {
+	var position int
+	err := r.DB.Model(&models.Media{}).Where("pet_id = ? AND is_active = ?", petID, true).
+		Select("COALESCE(MAX(position), 0) + 1").Scan(&position).Error
+	return position, err
+}
+
+// UpdatePetAvatar swaps in a processed avatar without touching the pet's other fields
+func (r *PetRepository) UpdatePetAvatar(petID, key string, variants models.ImageVariants) error {
+	now := time.Now()
+	pet := models.Pet{AvtKey: key, AvtVariants: variants}
+	pet.UpdatedAt = &now
+	return r.DB.Model(&models.Pet{}).Where("id = ?", petID).
+		Select("avt_url", "avt_variants", "updated_at").Updates(&pet).Error
+}
+
+// UpdatePetCover sets or, with an empty ID, clears the pet's cover photo
+func (r *PetRepository) UpdatePetCover(petID, mediaID string) error {
+	now := time.Now()
+	pet := models.Pet{CoverMediaID: mediaID}
+	pet.UpdatedAt = &now
+	return r.DB.Model(&models.Pet{}).Where("id = ?", petID).
+		Select("cover_media_id", "updated_at").Updates(&pet).Error
+}
+
+// Direct uploads
+
+func (r *PetRepository) CreateMediaUpload(upload *models.MediaUpload) error {
+	return r.DB.Create(upload).Error
+}
+
+func (r *PetRepository) GetMediaUploadByID(id string) (*models.MediaUpload, error) {
+	var upload models.MediaUpload
+	err := r.DB.Where("id = ? AND is_active = ?", id, true).First(&upload).Error
+	if err != nil {
+		return nil, err
+	}
+	return &upload, nil
+}
+
+func (r *PetRepository) UpdateMediaUpload(upload *models.MediaUpload) error {
+	return r.DB.Save(upload).Error
+}
+
+// GetExpiredMediaUploads lists pending uploads that were never completed, oldest first
+func (r *PetRepository) GetExpiredMediaUploads(before time.Time, limit int) ([]models.MediaUpload, error) {
+	var uploads []models.MediaUpload
+	err := r.DB.Where("status = ? AND expires_at < ? AND is_active = ?", utils.UploadStatusPending, before, true).
+		Order("expires_at ASC").Limit(limit).Find(&uploads).Error
+	return uploads, err
+}
+
+// Memorial tributes
+
+func (r *PetRepository) CreateTribute(tribute *models.PetTribute) error {
+	return r.DB.Create(tribute).Error
+}
+
+func (r *PetRepository) GetTributeByID(id string) (*models.PetTribute, error) {
+	var tribute models.PetTribute
+	err := r.DB.Where("id = ? AND is_active = ?", id, true).First(&tribute).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tribute, nil
+}
+
+func (r *PetRepository) UpdateTribute(tribute *models.PetTribute) error {
+	return r.DB.Omit(clause.Associations).Save(tribute).Error
+}
+
+func (r *PetRepository) GetTributesByPetID(petID string) ([]models.PetTribute, error) {
+	var tributes []models.PetTribute
+	err := r.DB.Preload("User").Where("pet_id = ? AND is_active = ?", petID, true).Order("created_at DESC").Find(&tributes).Error
+	return tributes, err
+}
+
+// Data exports
+
+func (r *PetRepository) CreateExport(export *models.PetExport) error {
+	return r.DB.Create(export).Error
+}
+
+func (r *PetRepository) GetExportByID(id string) (*models.PetExport, error) {
+	var export models.PetExport
+	err := r.DB.Where("id = ? AND is_active = ?", id, true).First(&export).Error
+	if err != nil {
+		return nil, err
+	}
+	return &export, nil
+}
+
+func (r *PetRepository) UpdateExport(export *models.PetExport) error {
+	return r.DB.Omit(clause.Associations).Save(export).Error
+}
+
+// FailStaleExports marks exports pending or running since before the given time failed
+// and returns how many there were
+func (r *PetRepository) FailStaleExports(before time.Time, reason string) (int64, error) {
+	now := time.Now()
+	result := r.DB.Model(&models.PetExport{}).
+		Where("status IN ? AND COALESCE(updated_at, created_at) < ? AND is_active = ?",
+			[]string{utils.ExportStatusPending, utils.ExportStatusRunning}, before, true).
+		Updates(map[string]interface{}{"status": utils.ExportStatusFailed, "error": reason, "completed_at": now, "updated_at": now})
+	return result.RowsAffected, result.Error
+}
+
+// GetExpiredExports lists exports whose retention ended before the given time, oldest first
+func (r *PetRepository) GetExpiredExports(before time.Time, limit int) ([]models.PetExport, error) {
+	var exports []models.PetExport
+	err := r.DB.Where("expires_at < ? AND is_active = ?", before, true).
+		Order("expires_at ASC").Limit(limit).Find(&exports).Error
+	return exports, err
+}
+
+// Bulk imports
+
+// GetPetByExternalID finds a pet the user owns by the ID from their own records
+func (r *PetRepository) GetPetByExternalID(userID, externalID string) (*models.Pet, error) {
+	var pet models.Pet
+	err := r.DB.Where("user_id = ? AND external_id = ? AND is_active = ?", userID, externalID, true).First(&pet).Error
+	if err != nil {
+		return nil, err
+	}
+	return &pet, nil
+}
+
+func (r *PetRepository) CreateImport(petImport *models.PetImport) error {
+	return r.DB.Create(petImport).Error
+}
+
+func (r *PetRepository) GetImportByID(id string) (*models.PetImport, error) {
+	var petImport models.PetImport
+	err := r.DB.Where("id = ? AND is_active = ?", id, true).First(&petImport).Error
+	if err != nil {
+		return nil, err
+	}
+	return &petImport, nil
+}
+
+func (r *PetRepository) UpdateImport(petImport *models.PetImport) error {
+	return r.DB.Save(petImport).Error
+}
+
+// FailStaleImports marks imports pending or running without progress since before the
+// given time failed and returns how many there were
+func (r *PetRepository) FailStaleImports(before time.Time, reason string) (int64, error) {
+	now := time.Now()
+	result := r.DB.Model(&models.PetImport{}).
+		Where("status IN ? AND COALESCE(updated_at, created_at) < ? AND is_active = ?",
+			[]string{utils.ImportStatusPending, utils.ImportStatusRunning}, before, true).
+		Updates(map[string]interface{}{"status": utils.ImportStatusFailed, "error": reason, "completed_at": now, "updated_at": now})
+	return result.RowsAffected, result.Error
+}
+
+// isDuplicateKey reports whether err is a unique constraint violation
+func isDuplicateKey(db *gorm.DB, err error) bool {
+	if translator, ok := db.Dialector.(gorm.ErrorTranslator); ok && err != nil {
+		err = translator.Translate(err)
+	}
+	return errors.Is(err, gorm.ErrDuplicatedKey)
+}
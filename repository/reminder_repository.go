@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"pet-service/models"
+	"pet-service/utils"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DueRecord is a vaccination or medication with an upcoming due date
+type DueRecord struct {
+	RecordType string
+	RecordID   string
+	Title      string
+	DueAt      time.Time
+	PetID      string
+	PetName    string
+	OwnerID    string
+}
+
+type ReminderRepository struct {
+	DB *gorm.DB
+}
+
+func NewReminderRepository(db *gorm.DB) *ReminderRepository {
+	return &ReminderRepository{DB: db}
+}
+
+// GetDueRecords returns active vaccinations and ongoing medications of active,
+// living pets whose next due date falls in [from, to)
+func (r *ReminderRepository) GetDueRecords(from, to time.Time) ([]DueRecord, error) {
+	var records []DueRecord
+
+	err := r.DB.Raw(`
+		SELECT ? AS record_type, v.id AS record_id, v.vaccine AS title, v.next_due_at AS due_at,
+			p.id AS pet_id, p.name AS pet_name, p.user_id AS owner_id
+		FROM vaccinations v
+		JOIN pets p ON p.id = v.pet_id
+		WHERE v.is_active = true AND p.is_active = true AND p.date_of_death IS NULL
+			AND v.next_due_at >= ? AND v.next_due_at < ?
+		UNION ALL
+		SELECT ? AS record_type, m.id AS record_id, m.name AS title, m.next_due_at AS due_at,
+			p.id AS pet_id, p.name AS pet_name, p.user_id AS owner_id
+		FROM medications m
+		JOIN pets p ON p.id = m.pet_id
+		WHERE m.is_active = true AND p.is_active = true AND p.date_of_death IS NULL
+			AND (m.end_date IS NULL OR m.end_date >= ?)
+			AND m.next_due_at >= ? AND m.next_due_at < ?
+		ORDER BY due_at`,
+		utils.ReminderRecordVaccination, from, to,
+		utils.ReminderRecordMedication, from, from, to).
+		Scan(&records).Error
+
+	return records, err
+}
+
+// ClaimReminder stores a delivery row and reports whether this call created it.
+// The unique index makes the claim safe across restarts and multiple instances.
+func (r *ReminderRepository) ClaimReminder(delivery *models.ReminderDelivery) (bool, error) {
+	result := r.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(delivery)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ReleaseReminder removes a claim so the reminder is retried on the next run
+func (r *ReminderRepository) ReleaseReminder(delivery *models.ReminderDelivery) error {
+	return r.DB.Delete(delivery).Error
+}
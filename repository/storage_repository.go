@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"pet-service/models"
+	"pet-service/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// StorageRepository loads every object key the database refers to, for reconciling
+// storage. Inactive rows are included so their files are never collected.
+type StorageRepository struct {
+	DB *gorm.DB
+}
+
+func NewStorageRepository(db *gorm.DB) *StorageRepository {
+	return &StorageRepository{DB: db}
+}
+
+func (r *StorageRepository) GetMediaObjects() ([]models.Media, error) {
+	var medias []models.Media
+	err := r.DB.Select("id", "pet_id", "url", "variants", "status").Find(&medias).Error
+	return medias, err
+}
+
+func (r *StorageRepository) GetMediaBlobs() ([]models.MediaBlob, error) {
+	var blobs []models.MediaBlob
+	err := r.DB.Select("hash", "key", "variants", "ref_count", "ready").Find(&blobs).Error
+	return blobs, err
+}
+
+// GetOpenUploadIDs lists direct uploads that can still be completed
+func (r *StorageRepository) GetOpenUploadIDs(now time.Time) ([]string, error) {
+	var ids []string
+	err := r.DB.Model(&models.MediaUpload{}).
+		Where("status = ? AND expires_at > ? AND is_active = ?", utils.UploadStatusPending, now, true).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+func (r *StorageRepository) GetPetAvatars() ([]models.Pet, error) {
+	var pets []models.Pet
+	err := r.DB.Select("id", "avt_url", "avt_variants").Where("avt_url <> ''").Find(&pets).Error
+	return pets, err
+}
+
+func (r *StorageRepository) GetSightingPhotos() ([]models.PetSighting, error) {
+	var sightings []models.PetSighting
+	err := r.DB.Select("id", "photo_url", "photo_variants").Where("photo_url <> ''").Find(&sightings).Error
+	return sightings, err
+}
@@ -49,10 +49,9 @@ func (r *UserRepository) GetUserByID(id string) (*models.User, error) {
 	return &user, nil
 }
 
-func (r *UserRepository) GetUsers() ([]models.User, error) {
-	var users []models.User
-	err := r.DB.Where("is_active = ?", true).Find(&users).Error
-	return users, err
+func (r *UserRepository) GetUsers(page PageRequest) ([]models.User, PageInfo, error) {
+	query := r.DB.Model(&models.User{}).Where("is_active = ?", true)
+	return Paginate[models.User](query, page, "created_at", "id", "created_at DESC, id DESC")
 }
 
 func (r *UserRepository) UpdateUser(user *models.User) error {
@@ -136,11 +135,20 @@ func (r *UserRepository) UpdateComment(comment *models.Comment) error {
 func (r *UserRepository) GetCommentsByPetID(petID string) ([]map[string]interface{}, error) {
 	var results []map[string]interface{}
 
-	err := r.DB.Table("comments").
-		Select("comments.id, comments.content, comments.created_at, comments.updated_at, comments.parent_id, users.last_name, users.first_name, users.id as user_id, users.avatar_url").
-		Joins("JOIN users ON users.id = comments.created_by").
-		Where("comments.pet_id = ?", petID).
-		Scan(&results).Error
+	err := r.commentsQuery(petID).Scan(&results).Error
 
 	return results, err
 }
+
+// GetCommentPage lists one page of a pet's comments, newest first
+func (r *UserRepository) GetCommentPage(petID string, page PageRequest) ([]map[string]interface{}, PageInfo, error) {
+	return Paginate[map[string]interface{}](r.commentsQuery(petID), page,
+		"comments.created_at", "comments.id", "comments.created_at DESC, comments.id DESC")
+}
+
+func (r *UserRepository) commentsQuery(petID string) *gorm.DB {
+	return r.DB.Table("comments").
+		Select("comments.id, comments.content, comments.created_at, comments.updated_at, comments.parent_id, users.last_name, users.first_name, users.id as user_id, users.avatar_url").
+		Joins("JOIN users ON users.id = comments.created_by").
+		Where("comments.pet_id = ? AND comments.is_active = ?", petID, true)
+}
@@ -3,15 +3,12 @@ package routes
 import (
 "pet-service/container"
 "pet-service/middleware"
+"pet-service/utils"
 
 "github.com/gin-gonic/gin"
-"gorm.io/gorm"
 )
 
-func SetupRoutes(router *gin.Engine, db *gorm.DB) {
-	// Initialize dependency injection container
-	c := container.NewContainer(db)
-
+func SetupRoutes(router *gin.Engine, c *container.Container) {
 	// API v1 group
 	v1 := router.Group("/api/v1")
 	{
@@ -22,6 +19,44 @@ func SetupRoutes(router *gin.Engine, db *gorm.DB) {
 			auth.POST("/user", c.Handlers.User.Register)
 		}
 
+		// Public share links (no auth required)
+		public := v1.Group("/public")
+		{
+			public.GET("/pet/:id", c.Handlers.Pet.GetPublicPet)
+		}
+		v1.GET("/p/:slug", c.Handlers.Share.GetPublicProfile)
+
+		// Objects of the local and memory storage backends (no auth required; links and
+		// upload policies are signed)
+		blobs := v1.Group("/blobs")
+		{
+			blobs.GET("/*key", c.Handlers.Blob.ServeBlob)
+			blobs.HEAD("/*key", c.Handlers.Blob.ServeBlob)
+			blobs.POST("", c.Handlers.Blob.UploadBlob)
+		}
+
+		// Lost & found (no auth required; signed-in reporters are recorded)
+		lostPets := v1.Group("/lost-pets")
+		lostPets.Use(middleware.OptionalAuthMiddleware())
+		{
+			lostPets.GET("", c.Handlers.LostPet.GetLostPets)
+			lostPets.POST("/:pet_id/sightings", c.Handlers.LostPet.ReportSighting)
+		}
+
+		// Species/breed catalog (reads are public, writes are admin only)
+		catalog := v1.Group("/catalog")
+		{
+			catalog.GET("/species", c.Handlers.Catalog.GetSpecies)
+			catalog.GET("/breeds", c.Handlers.Catalog.GetBreeds)
+		}
+		catalogAdmin := v1.Group("/catalog")
+		catalogAdmin.Use(middleware.AuthMiddleware())
+		{
+			catalogAdmin.POST("/species", c.Handlers.Catalog.CreateSpecies)
+			catalogAdmin.POST("/breeds", c.Handlers.Catalog.CreateBreed)
+			catalogAdmin.POST("/terms", c.Handlers.Catalog.AddTerm)
+		}
+
 		// Protected user routes
 		users := v1.Group("")
 		users.Use(middleware.AuthMiddleware())
@@ -30,6 +65,7 @@ func SetupRoutes(router *gin.Engine, db *gorm.DB) {
 			users.POST("/logout", c.Handlers.User.Logout)
 			users.GET("/users", c.Handlers.User.GetUsers)
 			users.PATCH("/users/change-password", c.Handlers.User.ChangePassword)
+			users.PATCH("/users/privacy", c.Handlers.User.UpdatePrivacy)
 		}
 
 		// Comment routes (protected)
@@ -51,6 +87,80 @@ func SetupRoutes(router *gin.Engine, db *gorm.DB) {
 			pets.POST("/pet/life-event", c.Handlers.Pet.CreatePetLifeEvent)
 			pets.POST("/pet/:pet_id/images", c.Handlers.Pet.UploadAvatar)
 			pets.POST("/pet/:pet_id/gallery", c.Handlers.Pet.UploadGallery)
+			pets.POST("/pet/:pet_id/media/upload-url", c.Handlers.Pet.CreateMediaUploadURL)
+			pets.POST("/pet/:pet_id/media/complete", c.Handlers.Pet.CompleteMediaUpload)
+			pets.PATCH("/pet/:pet_id", c.Handlers.Pet.UpdatePet)
+			pets.PATCH("/pet/:pet_id/visibility", c.Handlers.Pet.UpdateVisibility)
+		}
+
+		// Pedigree routes (protected)
+		pedigree := v1.Group("")
+		pedigree.Use(middleware.AuthMiddleware())
+		{
+			pedigree.PATCH("/pet/:pet_id/parents", c.Handlers.Pedigree.SetParents)
+			pedigree.GET("/pet/:id/pedigree", c.Handlers.Pedigree.GetPedigree)
+			pedigree.GET("/pet/:id/siblings", c.Handlers.Pedigree.GetSiblings)
+			pedigree.GET("/pet/:id/offspring", c.Handlers.Pedigree.GetOffspring)
+		}
+
+		// Memorial routes (protected)
+		memorial := v1.Group("")
+		memorial.Use(middleware.AuthMiddleware())
+		{
+			memorial.GET("/pet/:id/tributes", c.Handlers.Memorial.GetTributes)
+			memorial.POST("/pet/:pet_id/tributes", c.Handlers.Memorial.CreateTribute)
+			memorial.DELETE("/pet/:pet_id/tributes/:tribute_id", c.Handlers.Memorial.DeleteTribute)
+			memorial.GET("/pet/:id/archive", c.Handlers.Memorial.GetArchive)
+		}
+
+		// Pet data export routes (protected)
+		exports := v1.Group("")
+		exports.Use(middleware.AuthMiddleware())
+		{
+			exports.GET("/pet/:id/export", c.Handlers.Export.ExportPet)
+			exports.GET("/pet-exports/:id", c.Handlers.Export.GetExport)
+			exports.GET("/pet-exports/:id/download", c.Handlers.Export.DownloadExport)
+		}
+
+		// Bulk pet import routes (protected)
+		imports := v1.Group("")
+		imports.Use(middleware.AuthMiddleware())
+		{
+			imports.POST("/pet-imports", c.Handlers.Import.StartImport)
+			imports.GET("/pet-imports/:id", c.Handlers.Import.GetImport)
+		}
+
+		// Co-owner and ownership transfer routes (protected)
+		members := v1.Group("")
+		members.Use(middleware.AuthMiddleware())
+		{
+			members.GET("/pet/:id/members", c.Handlers.Member.GetMembers)
+			members.POST("/pet/:pet_id/members", c.Handlers.Member.InviteMember)
+			members.PATCH("/pet/:pet_id/members/:member_id", c.Handlers.Member.UpdateMemberRole)
+			members.DELETE("/pet/:pet_id/members/:member_id", c.Handlers.Member.RemoveMember)
+			members.POST("/pet/:pet_id/transfer", c.Handlers.Member.TransferOwnership)
+			members.DELETE("/pet/:pet_id/transfer", c.Handlers.Member.CancelTransfer)
+			members.GET("/pet-invites", c.Handlers.Member.GetInvites)
+			members.POST("/pet-invites/:id/accept", c.Handlers.Member.AcceptInvite)
+			members.DELETE("/pet-invites/:id", c.Handlers.Member.DeclineInvite)
+			members.GET("/pet-transfers", c.Handlers.Member.GetTransfers)
+			members.POST("/pet-transfers/:id/accept", c.Handlers.Member.AcceptTransfer)
+			members.DELETE("/pet-transfers/:id", c.Handlers.Member.DeclineTransfer)
+		}
+
+		// Gallery management routes (protected)
+		gallery := v1.Group("")
+		gallery.Use(middleware.AuthMiddleware())
+		{
+			gallery.DELETE("/media/:id", c.Handlers.Media.DeleteMedia)
+			gallery.PATCH("/media/:id", c.Handlers.Media.UpdateMedia)
+			gallery.PATCH("/pet/:pet_id/media/order", c.Handlers.Media.ReorderMedias)
+			gallery.PATCH("/pet/:pet_id/cover", c.Handlers.Media.SetCover)
+			gallery.PATCH("/pet/:pet_id/avatar", c.Handlers.Media.SetAvatar)
+			gallery.GET("/pet/:id/albums", c.Handlers.Media.GetAlbums)
+			gallery.POST("/pet/:pet_id/albums", c.Handlers.Media.CreateAlbum)
+			gallery.PATCH("/pet/:pet_id/albums/:album_id", c.Handlers.Media.UpdateAlbum)
+			gallery.DELETE("/pet/:pet_id/albums/:album_id", c.Handlers.Media.DeleteAlbum)
 		}
 
 		// Appointment routes (protected)
@@ -58,6 +168,75 @@ func SetupRoutes(router *gin.Engine, db *gorm.DB) {
 		appointments.Use(middleware.AuthMiddleware())
 		{
 			appointments.POST("/appointment/register", c.Handlers.Appointment.RegisterAppointment)
+			appointments.GET("/appointments", c.Handlers.Appointment.GetAppointments)
+		}
+
+		// Share link & QR routes (protected)
+		share := v1.Group("")
+		share.Use(middleware.AuthMiddleware())
+		{
+			share.GET("/pet/:id/share-link", c.Handlers.Share.GetShareLink)
+			share.POST("/pet/:pet_id/share-link", c.Handlers.Share.RotateShareLink)
+			share.DELETE("/pet/:pet_id/share-link", c.Handlers.Share.RevokeShareLink)
+			share.GET("/pet/:id/qr.png", c.Handlers.Share.GetQRCode)
+		}
+
+		// Follow & feed routes (protected)
+		feed := v1.Group("")
+		feed.Use(middleware.AuthMiddleware())
+		{
+			feed.POST("/pet/:pet_id/follow", c.Handlers.Feed.FollowPet)
+			feed.DELETE("/pet/:pet_id/follow", c.Handlers.Feed.UnfollowPet)
+			feed.GET("/pet/:id/follow-requests", c.Handlers.Feed.GetFollowRequests)
+			feed.POST("/pet/:pet_id/follow-requests/:user_id/approve", c.Handlers.Feed.ApproveFollowRequest)
+			feed.DELETE("/pet/:pet_id/follow-requests/:user_id", c.Handlers.Feed.RejectFollowRequest)
+			feed.GET("/feed", c.Handlers.Feed.GetFeed)
+		}
+
+		// Lost pet owner routes (protected)
+		lost := v1.Group("")
+		lost.Use(middleware.AuthMiddleware())
+		{
+			lost.PATCH("/pet/:pet_id/lost", c.Handlers.LostPet.UpdateLostStatus)
+			lost.GET("/pet/:id/sightings", c.Handlers.LostPet.GetSightings)
+		}
+
+		// Medical record routes (protected; writes are limited to clinic staff)
+		medical := v1.Group("")
+		medical.Use(middleware.AuthMiddleware())
+		{
+			medical.GET("/pet/:id/medical-records", c.Handlers.Medical.GetMedicalRecords)
+		}
+		medicalStaff := v1.Group("")
+		medicalStaff.Use(middleware.AuthMiddleware(), middleware.PermissionMiddleware([]string{utils.PermissionEditPet}))
+		{
+			medicalStaff.POST("/pet/:pet_id/vaccinations", c.Handlers.Medical.CreateVaccination)
+			medicalStaff.PATCH("/pet/:pet_id/vaccinations/:record_id", c.Handlers.Medical.UpdateVaccination)
+			medicalStaff.DELETE("/pet/:pet_id/vaccinations/:record_id", c.Handlers.Medical.DeleteVaccination)
+			medicalStaff.POST("/pet/:pet_id/treatments", c.Handlers.Medical.CreateTreatment)
+			medicalStaff.PATCH("/pet/:pet_id/treatments/:record_id", c.Handlers.Medical.UpdateTreatment)
+			medicalStaff.DELETE("/pet/:pet_id/treatments/:record_id", c.Handlers.Medical.DeleteTreatment)
+			medicalStaff.POST("/pet/:pet_id/medications", c.Handlers.Medical.CreateMedication)
+			medicalStaff.PATCH("/pet/:pet_id/medications/:record_id", c.Handlers.Medical.UpdateMedication)
+			medicalStaff.DELETE("/pet/:pet_id/medications/:record_id", c.Handlers.Medical.DeleteMedication)
+		}
+
+		// Growth tracking routes (protected)
+		growth := v1.Group("")
+		growth.Use(middleware.AuthMiddleware())
+		{
+			growth.GET("/pet/:id/measurements", c.Handlers.Measurement.GetMeasurements)
+			growth.GET("/pet/:id/measurements/series", c.Handlers.Measurement.GetMeasurementSeries)
+			growth.POST("/pet/:pet_id/measurements", c.Handlers.Measurement.CreateMeasurement)
+			growth.DELETE("/pet/:pet_id/measurements/:measurement_id", c.Handlers.Measurement.DeleteMeasurement)
+		}
+
+		// Notification routes (protected)
+		notifications := v1.Group("")
+		notifications.Use(middleware.AuthMiddleware())
+		{
+			notifications.GET("/notifications", c.Handlers.Notification.GetNotifications)
+			notifications.PATCH("/notifications/:id/read", c.Handlers.Notification.MarkRead)
 		}
 	}
 }
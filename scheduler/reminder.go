@@ -0,0 +1,149 @@
+package scheduler
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/utils"
+	"sort"
+	"time"
+)
+
+// Notifier delivers an in-app notification to a user
+type Notifier interface {
+	Notify(userID, notificationType, title, body, link string) error
+}
+
+// ReminderJob notifies owners about vaccinations and medications that are coming due.
+// Each record gets at most one reminder per window and due date.
+type ReminderJob struct {
+	reminderRepo repository.IReminderRepository
+	notifier     Notifier
+	windows      []int
+	bookingURL   string
+}
+
+// NewReminderJob creates a reminder job for the given windows (days before the due date)
+func NewReminderJob(reminderRepo repository.IReminderRepository, notifier Notifier, windows []int, publicWebURL string) *ReminderJob {
+	sorted := append([]int(nil), windows...)
+	sort.Ints(sorted)
+
+	return &ReminderJob{
+		reminderRepo: reminderRepo,
+		notifier:     notifier,
+		windows:      sorted,
+		bookingURL:   publicWebURL + "/appointments/new",
+	}
+}
+
+// Run sends the reminders that are due today
+func (j *ReminderJob) Run() {
+	if len(j.windows) == 0 {
+		return
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	until := today.AddDate(0, 0, j.windows[len(j.windows)-1]+1)
+
+	records, err := j.reminderRepo.GetDueRecords(today, until)
+	if err != nil {
+		log.Printf("Reminder job: failed to load due records: %v", err)
+		return
+	}
+
+	sent := 0
+	for _, record := range records {
+		dueAt := record.DueAt.In(now.Location())
+		dueDate := time.Date(dueAt.Year(), dueAt.Month(), dueAt.Day(), 0, 0, 0, 0, now.Location())
+		daysLeft := daysBetween(today, dueDate)
+
+		window, ok := j.windowFor(daysLeft)
+		if !ok {
+			continue
+		}
+
+		if j.deliver(record, window, dueDate, daysLeft) {
+			sent++
+		}
+	}
+
+	log.Printf("Reminder job: %d due records, %d reminders sent", len(records), sent)
+}
+
+// daysBetween counts calendar days from one date to another. The dates are compared as
+// UTC midnights, since days in TIME_ZONE can be 23 or 25 hours long around DST changes.
+func daysBetween(from, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
+
+// windowFor returns the smallest window that contains daysLeft. Larger windows
+// that were missed (e.g. the record was added late) are skipped rather than sent together.
+func (j *ReminderJob) windowFor(daysLeft int) (int, bool) {
+	for _, window := range j.windows {
+		if daysLeft <= window {
+			return window, true
+		}
+	}
+	return 0, false
+}
+
+func (j *ReminderJob) deliver(record repository.DueRecord, window int, dueDate time.Time, daysLeft int) bool {
+	delivery := &models.ReminderDelivery{
+		RecordType: record.RecordType,
+		RecordID:   record.RecordID,
+		WindowDays: window,
+		DueDate:    dueDate,
+		UserID:     record.OwnerID,
+	}
+
+	claimed, err := j.reminderRepo.ClaimReminder(delivery)
+	if err != nil {
+		log.Printf("Reminder job: failed to claim %s %s: %v", record.RecordType, record.RecordID, err)
+		return false
+	}
+	if !claimed {
+		// Already sent by an earlier run or another instance
+		return false
+	}
+
+	notificationType := utils.NotificationVaccinationDue
+	if record.RecordType == utils.ReminderRecordMedication {
+		notificationType = utils.NotificationMedicationDue
+	}
+
+	when := fmt.Sprintf("in %d days", daysLeft)
+	switch daysLeft {
+	case 0:
+		when = "today"
+	case 1:
+		when = "tomorrow"
+	}
+
+	title := fmt.Sprintf("%s is due %s for %s", record.Title, when, record.PetName)
+	body := fmt.Sprintf("%s for %s is due on %s. Book an appointment so you don't miss it.",
+		record.Title, record.PetName, dueDate.Format("2006-01-02"))
+
+	if err := j.notifier.Notify(record.OwnerID, notificationType, title, body, j.bookingLink(record)); err != nil {
+		// Give the claim back so the next run retries
+		if releaseErr := j.reminderRepo.ReleaseReminder(delivery); releaseErr != nil {
+			log.Printf("Reminder job: failed to release %s %s: %v", record.RecordType, record.RecordID, releaseErr)
+		}
+		return false
+	}
+
+	return true
+}
+
+// bookingLink is a deep link into the web app's booking form, prefilled for the pet
+func (j *ReminderJob) bookingLink(record repository.DueRecord) string {
+	query := url.Values{}
+	query.Set("pet_id", record.PetID)
+	query.Set("reason", record.RecordType)
+	query.Set("record_id", record.RecordID)
+	return j.bookingURL + "?" + query.Encode()
+}
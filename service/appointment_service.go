@@ -1,10 +1,13 @@
 package service
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"pet-service/dto"
 	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/repository"
 	"pet-service/scheduler"
 	"pet-service/utils"
 	"time"
@@ -13,17 +16,32 @@ import (
 )
 
 type appointmentService struct {
-	db *gorm.DB
+	db      *gorm.DB
+	petRepo repository.IPetRepository
 }
 
 // NewAppointmentService creates a new appointment service instance
-func NewAppointmentService(db *gorm.DB) IAppointmentService {
+func NewAppointmentService(db *gorm.DB, petRepo repository.IPetRepository) IAppointmentService {
 	return &appointmentService{
-		db: db,
+		db:      db,
+		petRepo: petRepo,
 	}
 }
 
 func (s *appointmentService) RegisterAppointment(userInfo middleware.UserInfo, req dto.AppointmentRequest) (*dto.AppointmentResponse, error) {
+	if req.PetID != "" {
+		pet, err := s.petRepo.GetPetByID(req.PetID)
+		if err != nil {
+			return nil, errors.New(utils.PetIDNotExist)
+		}
+		if petAccessLevel(&userInfo, pet, false) < petAccessCaretaker {
+			return nil, errors.New(utils.PermissionDenied)
+		}
+		if isMemorial(pet) {
+			return nil, errors.New(utils.PetIsMemorial)
+		}
+	}
+
 	code := utils.GenerateTransactionCode()
 
 	// Schedule email to be sent 10 seconds later
@@ -51,3 +69,42 @@ func (s *appointmentService) RegisterAppointment(userInfo middleware.UserInfo, r
 		StartTime: req.StartTime,
 	}, nil
 }
+
+// GetAppointments lists the current user's appointments, newest first
+func (s *appointmentService) GetAppointments(userInfo middleware.UserInfo, query dto.PageQuery) (*dto.PaginationResponse, error) {
+	page, err := pageRequest(query)
+	if err != nil {
+		return nil, err
+	}
+
+	appointments, info, err := repository.Paginate[models.Appointment](
+		s.db.Model(&models.Appointment{}).Where("user_id = ? AND is_active = ?", userInfo.UserID, true),
+		page, "created_at", "id", "created_at DESC, id DESC")
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.AppointmentItem, 0, len(appointments))
+	for i := range appointments {
+		items = append(items, toAppointmentItem(&appointments[i]))
+	}
+
+	return &dto.PaginationResponse{
+		Data: items,
+		Meta: pageMeta(page, info, appointments, func(a *models.Appointment) (time.Time, string) {
+			return a.CreatedAt, a.ID
+		}),
+	}, nil
+}
+
+func toAppointmentItem(a *models.Appointment) dto.AppointmentItem {
+	return dto.AppointmentItem{
+		ID:         a.ID,
+		Code:       a.Code,
+		Status:     a.Status,
+		StartTime:  formatOptionalDateTime(a.StartTime),
+		TotalPrice: a.TotalPrice,
+		IsOnline:   a.IsOnline,
+		CreatedAt:  a.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,323 @@
+package service
+
+import (
+	"errors"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/utils"
+	"regexp"
+	"strings"
+)
+
+var catalogCodePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+type catalogService struct {
+	catalogRepo repository.ICatalogRepository
+}
+
+// NewCatalogService creates a new species/breed catalog service instance
+func NewCatalogService(catalogRepo repository.ICatalogRepository) ICatalogService {
+	return &catalogService{
+		catalogRepo: catalogRepo,
+	}
+}
+
+func (s *catalogService) GetSpecies(q, locale string, limit int) ([]dto.CatalogItem, error) {
+	if strings.TrimSpace(q) == "" {
+		species, err := s.catalogRepo.GetAllSpecies()
+		if err != nil {
+			return nil, err
+		}
+		items := make([]dto.CatalogItem, 0, len(species))
+		for _, sp := range species {
+			items = append(items, dto.CatalogItem{Code: sp.Code, Name: sp.Name})
+		}
+		return s.localize(utils.CatalogEntitySpecies, items, locale), nil
+	}
+
+	return s.suggest(utils.CatalogEntitySpecies, "", q, locale, limit)
+}
+
+func (s *catalogService) GetBreeds(speciesCode, q, locale string, limit int) ([]dto.CatalogItem, error) {
+	if strings.TrimSpace(q) == "" {
+		if speciesCode == "" {
+			return []dto.CatalogItem{}, nil
+		}
+		breeds, err := s.catalogRepo.GetBreedsBySpecies(speciesCode)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]dto.CatalogItem, 0, len(breeds))
+		for _, b := range breeds {
+			items = append(items, dto.CatalogItem{Code: b.Code, Name: b.Name, SpeciesCode: b.SpeciesCode})
+		}
+		return s.localize(utils.CatalogEntityBreed, items, locale), nil
+	}
+
+	return s.suggest(utils.CatalogEntityBreed, speciesCode, q, locale, limit)
+}
+
+func (s *catalogService) CreateSpecies(userInfo middleware.UserInfo, req dto.CatalogSpeciesRequest) (*dto.CatalogItem, error) {
+	if !userInfo.IsAdmin {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+	if !catalogCodePattern.MatchString(req.Code) {
+		return nil, errors.New(utils.InvalidCatalogCode)
+	}
+	if _, err := s.catalogRepo.GetSpeciesByCode(req.Code); err == nil {
+		return nil, errors.New(utils.CatalogCodeTaken)
+	}
+
+	species := &models.Species{Code: req.Code, Name: req.Name}
+	species.CreatedBy = userInfo.UserID
+	if err := s.catalogRepo.CreateSpecies(species); err != nil {
+		return nil, err
+	}
+	s.createTerms(userInfo, utils.CatalogEntitySpecies, req.Code, req.Name, req.Names, req.Aliases)
+
+	return &dto.CatalogItem{Code: species.Code, Name: species.Name}, nil
+}
+
+func (s *catalogService) CreateBreed(userInfo middleware.UserInfo, req dto.CatalogBreedRequest) (*dto.CatalogItem, error) {
+	if !userInfo.IsAdmin {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+	if !catalogCodePattern.MatchString(req.Code) {
+		return nil, errors.New(utils.InvalidCatalogCode)
+	}
+	if _, err := s.catalogRepo.GetSpeciesByCode(req.SpeciesCode); err != nil || req.SpeciesCode == utils.CatalogOther {
+		return nil, errors.New(utils.InvalidSpecies)
+	}
+	if _, err := s.catalogRepo.GetBreedByCode(req.Code); err == nil {
+		return nil, errors.New(utils.CatalogCodeTaken)
+	}
+
+	breed := &models.Breed{Code: req.Code, SpeciesCode: req.SpeciesCode, Name: req.Name}
+	breed.CreatedBy = userInfo.UserID
+	if err := s.catalogRepo.CreateBreed(breed); err != nil {
+		return nil, err
+	}
+	s.createTerms(userInfo, utils.CatalogEntityBreed, req.Code, req.Name, req.Names, req.Aliases)
+
+	return &dto.CatalogItem{Code: breed.Code, Name: breed.Name, SpeciesCode: breed.SpeciesCode}, nil
+}
+
+func (s *catalogService) AddTerm(userInfo middleware.UserInfo, req dto.CatalogTermRequest) (*dto.CatalogItem, error) {
+	if !userInfo.IsAdmin {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	item := dto.CatalogItem{Code: req.Code, Matched: req.Term}
+	if req.EntityType == utils.CatalogEntitySpecies {
+		species, err := s.catalogRepo.GetSpeciesByCode(req.Code)
+		if err != nil {
+			return nil, errors.New(utils.InvalidSpecies)
+		}
+		item.Name = species.Name
+	} else {
+		breed, err := s.catalogRepo.GetBreedByCode(req.Code)
+		if err != nil {
+			return nil, errors.New(utils.InvalidBreed)
+		}
+		item.Name = breed.Name
+		item.SpeciesCode = breed.SpeciesCode
+	}
+
+	term := &models.CatalogTerm{
+		EntityType: req.EntityType,
+		Code:       req.Code,
+		Locale:     req.Locale,
+		Term:       req.Term,
+		Normalized: utils.NormalizeTerm(req.Term),
+		IsAlias:    req.IsAlias,
+	}
+	term.CreatedBy = userInfo.UserID
+	if err := s.catalogRepo.CreateTerm(term); err != nil {
+		return nil, errors.New(utils.CatalogCodeTaken)
+	}
+
+	return &item, nil
+}
+
+// suggest powers autocomplete: prefix match on any name or alias, one item per code
+func (s *catalogService) suggest(entityType, speciesCode, q, locale string, limit int) ([]dto.CatalogItem, error) {
+	if limit <= 0 || limit > utils.CatalogSuggestLimit {
+		limit = utils.CatalogSuggestLimit
+	}
+
+	prefix := utils.NormalizeTerm(q)
+	if prefix == "" {
+		return []dto.CatalogItem{}, nil
+	}
+
+	// Several terms can share a code, so over-fetch before de-duplicating
+	terms, err := s.catalogRepo.SuggestTerms(entityType, speciesCode, prefix, limit*3)
+	if err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	matched := make(map[string]string)
+	for _, t := range terms {
+		if _, ok := matched[t.Code]; ok {
+			continue
+		}
+		matched[t.Code] = t.Term
+		codes = append(codes, t.Code)
+		if len(codes) == limit {
+			break
+		}
+	}
+	if len(codes) == 0 {
+		return []dto.CatalogItem{}, nil
+	}
+
+	names := make(map[string]dto.CatalogItem)
+	if entityType == utils.CatalogEntitySpecies {
+		species, err := s.catalogRepo.GetSpeciesByCodes(codes)
+		if err != nil {
+			return nil, err
+		}
+		for _, sp := range species {
+			names[sp.Code] = dto.CatalogItem{Code: sp.Code, Name: sp.Name}
+		}
+	} else {
+		breeds, err := s.catalogRepo.GetBreedsByCodes(codes)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range breeds {
+			names[b.Code] = dto.CatalogItem{Code: b.Code, Name: b.Name, SpeciesCode: b.SpeciesCode}
+		}
+	}
+
+	items := make([]dto.CatalogItem, 0, len(codes))
+	for _, code := range codes {
+		item, ok := names[code]
+		if !ok {
+			continue
+		}
+		item.Matched = matched[code]
+		items = append(items, item)
+	}
+
+	return s.localize(entityType, items, locale), nil
+}
+
+// localize replaces canonical names with the locale's name when the catalog has one
+func (s *catalogService) localize(entityType string, items []dto.CatalogItem, locale string) []dto.CatalogItem {
+	if locale == "" || locale == utils.CatalogDefaultLocale || len(items) == 0 {
+		return items
+	}
+
+	codes := make([]string, 0, len(items))
+	for _, item := range items {
+		codes = append(codes, item.Code)
+	}
+	names, err := s.catalogRepo.GetLocalizedNames(entityType, codes, locale)
+	if err != nil {
+		return items
+	}
+
+	for i := range items {
+		if name, ok := names[items[i].Code]; ok {
+			items[i].Name = name
+		}
+	}
+	return items
+}
+
+func (s *catalogService) createTerms(userInfo middleware.UserInfo, entityType, code, name string, names map[string]string, aliases []string) {
+	terms := []models.CatalogTerm{{EntityType: entityType, Code: code, Locale: utils.CatalogDefaultLocale, Term: name}}
+	for locale, localized := range names {
+		terms = append(terms, models.CatalogTerm{EntityType: entityType, Code: code, Locale: locale, Term: localized})
+	}
+	for _, alias := range aliases {
+		terms = append(terms, models.CatalogTerm{EntityType: entityType, Code: code, Term: alias, IsAlias: true})
+	}
+
+	seen := make(map[string]bool)
+	for i := range terms {
+		terms[i].Normalized = utils.NormalizeTerm(terms[i].Term)
+		if terms[i].Normalized == "" || seen[terms[i].Normalized] {
+			continue
+		}
+		seen[terms[i].Normalized] = true
+		terms[i].CreatedBy = userInfo.UserID
+		_ = s.catalogRepo.CreateTerm(&terms[i])
+	}
+}
+
+// petCatalogMatch is a pet's type/breed after checking them against the catalog
+type petCatalogMatch struct {
+	SpeciesCode string
+	Type        string
+	BreedCode   string
+	Breed       string
+}
+
+// resolvePetCatalog validates a pet's species and breed. Codes win over text;
+// text is matched against catalog names and aliases in any language. The "other"
+// code keeps the free text as given.
+func resolvePetCatalog(catalogRepo repository.ICatalogRepository, speciesCode, typeText, breedCode, breedText string) (*petCatalogMatch, error) {
+	match := &petCatalogMatch{}
+	typeText = strings.TrimSpace(typeText)
+	breedText = strings.TrimSpace(breedText)
+
+	switch {
+	case speciesCode == utils.CatalogOther:
+		match.SpeciesCode = utils.CatalogOther
+		match.Type = typeText
+	case speciesCode != "":
+		species, err := catalogRepo.GetSpeciesByCode(speciesCode)
+		if err != nil {
+			return nil, errors.New(utils.InvalidSpecies)
+		}
+		match.SpeciesCode, match.Type = species.Code, species.Name
+	case typeText != "":
+		codes, err := catalogRepo.FindCodesByTerm(utils.CatalogEntitySpecies, utils.NormalizeTerm(typeText), "")
+		if err != nil || len(codes) != 1 {
+			return nil, errors.New(utils.InvalidSpecies)
+		}
+		species, err := catalogRepo.GetSpeciesByCode(codes[0])
+		if err != nil {
+			return nil, errors.New(utils.InvalidSpecies)
+		}
+		match.SpeciesCode, match.Type = species.Code, species.Name
+		if species.Code == utils.CatalogOther {
+			match.Type = typeText
+		}
+	}
+
+	switch {
+	case breedCode == utils.CatalogOther || (match.SpeciesCode == utils.CatalogOther && breedCode == ""):
+		// Free text breed, either explicitly or because the species itself is not in the catalog
+		if breedText != "" {
+			match.BreedCode = utils.CatalogOther
+		}
+		match.Breed = breedText
+	case breedCode != "":
+		breed, err := catalogRepo.GetBreedByCode(breedCode)
+		if err != nil || breed.SpeciesCode != match.SpeciesCode {
+			return nil, errors.New(utils.InvalidBreed)
+		}
+		match.BreedCode, match.Breed = breed.Code, breed.Name
+	case breedText != "":
+		if match.SpeciesCode == "" {
+			return nil, errors.New(utils.InvalidSpecies)
+		}
+		codes, err := catalogRepo.FindCodesByTerm(utils.CatalogEntityBreed, utils.NormalizeTerm(breedText), match.SpeciesCode)
+		if err != nil || len(codes) != 1 {
+			return nil, errors.New(utils.InvalidBreed)
+		}
+		breed, err := catalogRepo.GetBreedByCode(codes[0])
+		if err != nil {
+			return nil, errors.New(utils.InvalidBreed)
+		}
+		match.BreedCode, match.Breed = breed.Code, breed.Name
+	}
+
+	return match, nil
+}
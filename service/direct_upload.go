@@ -0,0 +1,260 @@
+package service
+
+import (
+	"errors"
+	"io"
+	"log"
+	"pet-service/config"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/storage"
+	"pet-service/utils"
+	"slices"
+	"time"
+)
+
+const (
+	// directUploadGrace leaves time to complete an upload that finished just as its policy expired
+	directUploadGrace = 15 * time.Minute
+
+	uploadCleanupBatch = 500
+)
+
+// CreateUploadURL authorizes one gallery file to be posted straight to storage. The
+// declared type and size are checked up front and pinned in the upload policy.
+func (s *petService) CreateUploadURL(userInfo middleware.UserInfo, petID string, req dto.MediaUploadURLRequest) (*dto.MediaUploadURLResponse, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) < petAccessCaretaker {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	name := utils.SanitizeFileName(req.FileName, mediaNameMaxLen)
+	contentType := utils.NormalizeContentType(req.ContentType)
+	allowed := append(slices.Clone(utils.ImageContentTypes), utils.VideoContentTypes...)
+	if !slices.Contains(allowed, contentType) {
+		return nil, &utils.FileValidationError{Field: "content_type", File: name, Message: utils.UploadTypeNotAllowed}
+	}
+	maxSize := mediaMaxFileSize(contentType)
+	if req.Size > maxSize {
+		return nil, &utils.FileValidationError{Field: "size", File: name, Message: utils.UploadFileTooLarge}
+	}
+
+	upload := &models.MediaUpload{
+		PetID:       petID,
+		Name:        name,
+		ContentType: contentType,
+		MaxSize:     req.Size,
+		Status:      utils.UploadStatusPending,
+	}
+	upload.ID = utils.GenerateUUID()
+	upload.CreatedBy = userInfo.UserID
+
+	expiry := config.AppConfig.DirectUploadExpiry
+	postURL, fields, err := s.store.PresignPost(incomingObjectName(upload.ID), contentType, req.Size, expiry)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().Add(expiry)
+	upload.ExpiresAt = expiresAt.Add(directUploadGrace)
+
+	if err := s.petRepo.CreateMediaUpload(upload); err != nil {
+		return nil, err
+	}
+
+	return &dto.MediaUploadURLResponse{
+		UploadID:  upload.ID,
+		URL:       postURL,
+		Method:    "POST",
+		Fields:    fields,
+		MaxSize:   upload.MaxSize,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// CompleteUpload checks a file posted with CreateUploadURL against what was declared,
+// then adds it to the gallery like a proxied upload: a file the pet already has gives
+// back the item holding it. A file that fails the checks is removed; one that hasn't
+// arrived yet can be completed again later.
+func (s *petService) CompleteUpload(userInfo middleware.UserInfo, petID string, req dto.MediaUploadCompleteRequest) (*dto.MediaResponse, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) < petAccessCaretaker {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	upload, err := s.petRepo.GetMediaUploadByID(req.UploadID)
+	if err != nil || upload.PetID != petID || upload.Status != utils.UploadStatusPending || time.Now().After(upload.ExpiresAt) {
+		return nil, errors.New(utils.UploadNotExist)
+	}
+
+	incoming := incomingObjectName(upload.ID)
+	info, err := s.store.Stat(incoming)
+	if err != nil {
+		if storage.IsNotExist(err) {
+			return nil, errors.New(utils.UploadNotReceived)
+		}
+		return nil, err
+	}
+
+	media, video, err := s.acceptDirectUpload(upload, incoming, info.Size)
+	if err != nil {
+		var fileErr *utils.FileValidationError
+		if errors.As(err, &fileErr) {
+			s.discardUpload(upload, utils.UploadStatusFailed)
+		}
+		return nil, err
+	}
+	defer func() {
+		if video != nil {
+			video.remove()
+		}
+	}()
+
+	existing, err := s.mediaRepo.GetMediasByContentHash(petID, []string{media.ContentHash})
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		s.discardUpload(upload, utils.UploadStatusCompleted)
+		response := toUploadedMediaResponse(s.store, &existing[0], pet.Visibility)
+		return &response, nil
+	}
+
+	poster := false
+	if video != nil {
+		poster, err = s.storeGalleryVideo(media, video, info.Size, upload.ContentType)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.store.Delete(incoming); err != nil {
+			log.Printf("Failed to remove posted upload %s: %v", incoming, err)
+		}
+	}
+
+	media.Position, err = s.petRepo.GetNextMediaPosition(petID)
+	if err == nil {
+		err = s.petRepo.CreateMediaBatch([]models.Media{*media})
+	}
+	if err != nil {
+		// A photo stays posted, so the upload can be completed again
+		if video != nil {
+			s.releaseMediaBlob(media.ContentHash)
+		}
+		return nil, err
+	}
+	if video == nil {
+		go s.processGalleryImage(*media)
+	} else if poster {
+		go s.processGalleryVideo(*media, video)
+		video = nil
+	}
+
+	now := time.Now()
+	upload.Status = utils.UploadStatusCompleted
+	upload.UpdatedAt = &now
+	upload.UpdatedBy = userInfo.UserID
+	if err := s.petRepo.UpdateMediaUpload(upload); err != nil {
+		log.Printf("Failed to mark upload %s completed: %v", upload.ID, err)
+	}
+
+	summary := "1 new photo"
+	if media.Type == utils.MediaTypeVideo {
+		summary = "1 new video"
+	}
+	recordActivity(s.feedRepo, userInfo.UserID, petID, utils.ActivityMedia, media.ID, summary, now)
+
+	response := toUploadedMediaResponse(s.store, media, pet.Visibility)
+	return &response, nil
+}
+
+// acceptDirectUpload runs the checks of a proxied upload on a posted object, hashes it
+// and builds its Media. Photos stay in incoming/ for the image pipeline; videos are
+// staged and probed, to be stored by the caller.
+func (s *petService) acceptDirectUpload(upload *models.MediaUpload, incoming string, size int64) (*models.Media, *stagedVideo, error) {
+	if size > upload.MaxSize {
+		return nil, nil, &utils.FileValidationError{Field: "file", File: upload.Name, Message: utils.UploadFileTooLarge}
+	}
+
+	object, err := s.store.Get(incoming)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer object.Close()
+
+	file := UploadFile{Reader: object, Name: upload.Name, ContentType: upload.ContentType, Size: size}
+	if err := checkUpload(&file, "file", []string{upload.ContentType}); err != nil {
+		return nil, nil, err
+	}
+
+	media := &models.Media{
+		Type:   utils.MediaKind(file.ContentType),
+		Name:   file.Name,
+		Status: utils.MediaStatusProcessing,
+		PetID:  upload.PetID,
+	}
+	media.ID = upload.ID
+	media.CreatedBy = upload.CreatedBy
+	if media.Type == utils.MediaTypeImage {
+		contentHash := hashUpload(&file)
+		if _, err := io.Copy(io.Discard, file.Reader); err != nil {
+			return nil, nil, err
+		}
+		media.ContentHash = contentHash()
+		return media, nil, nil
+	}
+
+	video, err := stageVideo(s.videoProcessor, &file, "file")
+	if err != nil {
+		return nil, nil, err
+	}
+	media.ContentHash = video.hash
+
+	return media, video, nil
+}
+
+// CleanupExpiredUploads removes the objects of uploads that were never completed. It
+// runs on UPLOAD_CLEANUP_CRON; anything beyond one batch is left for the next run.
+func (s *petService) CleanupExpiredUploads() {
+	uploads, err := s.petRepo.GetExpiredMediaUploads(time.Now(), uploadCleanupBatch)
+	if err != nil {
+		log.Printf("Upload cleanup: failed to load expired uploads: %v", err)
+		return
+	}
+	for i := range uploads {
+		s.discardUpload(&uploads[i], utils.UploadStatusExpired)
+	}
+	if len(uploads) > 0 {
+		log.Printf("Upload cleanup: discarded %d expired uploads", len(uploads))
+	}
+}
+
+// discardUpload removes an upload's object, if it arrived, and closes the upload with
+// the given status
+func (s *petService) discardUpload(upload *models.MediaUpload, status string) {
+	incoming := incomingObjectName(upload.ID)
+	if err := s.store.Delete(incoming); err != nil {
+		log.Printf("Failed to remove upload %s: %v", incoming, err)
+		return
+	}
+
+	now := time.Now()
+	upload.Status = status
+	upload.UpdatedAt = &now
+	if err := s.petRepo.UpdateMediaUpload(upload); err != nil {
+		log.Printf("Failed to close upload %s: %v", upload.ID, err)
+	}
+}
+
+// mediaMaxFileSize is the size cap for a gallery file of the given type
+func mediaMaxFileSize(contentType string) int64 {
+	if utils.MediaKind(contentType) == utils.MediaTypeVideo {
+		return config.AppConfig.VideoMaxFileSize
+	}
+	return config.AppConfig.UploadMaxFileSize
+}
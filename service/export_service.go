@@ -0,0 +1,378 @@
+package service
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"pet-service/config"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/storage"
+	"pet-service/utils"
+	"time"
+)
+
+const exportCleanupBatch = 500
+
+type exportService struct {
+	petRepo             repository.IPetRepository
+	userRepo            repository.IUserRepository
+	medicalRepo         repository.IMedicalRepository
+	notificationService INotificationService
+	store               storage.BlobStore
+}
+
+// NewExportService creates a new pet data export service instance
+func NewExportService(petRepo repository.IPetRepository, userRepo repository.IUserRepository, medicalRepo repository.IMedicalRepository, notificationService INotificationService, store storage.BlobStore) IExportService {
+	return &exportService{
+		petRepo:             petRepo,
+		userRepo:            userRepo,
+		medicalRepo:         medicalRepo,
+		notificationService: notificationService,
+		store:               store,
+	}
+}
+
+// ExportPet returns an archive to stream right away, or queues a background job and
+// returns it when the pet has more gallery files than EXPORT_SYNC_MAX_FILES or async is set
+func (s *exportService) ExportPet(userInfo middleware.UserInfo, petID string, async bool) (*PetArchive, *dto.PetExportItem, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) != petAccessOwner {
+		return nil, nil, errors.New(utils.PermissionDenied)
+	}
+
+	medias, err := s.petRepo.GetMediasByPetID(petID)
+	if err != nil {
+		return nil, nil, err
+	}
+	manifest, err := s.buildManifest(pet)
+	if err != nil {
+		return nil, nil, err
+	}
+	photos := archivePhotos(pet, medias)
+	fileName := archiveFileName(pet.Name) + "-export.zip"
+
+	if !async && len(medias) <= config.AppConfig.ExportSyncMaxFiles {
+		return &PetArchive{
+			FileName: fileName,
+			Write: func(w io.Writer) error {
+				return writePetExport(s.store, w, manifest, photos)
+			},
+		}, nil, nil
+	}
+
+	export := &models.PetExport{
+		PetID:    petID,
+		UserID:   userInfo.UserID,
+		Status:   utils.ExportStatusPending,
+		FileName: fileName,
+	}
+	export.CreatedBy = userInfo.UserID
+
+	if err := s.petRepo.CreateExport(export); err != nil {
+		return nil, nil, err
+	}
+
+	go s.runExport(export, pet.Name, manifest, photos)
+
+	item := toPetExportItem(export)
+	return nil, &item, nil
+}
+
+func (s *exportService) GetExport(userInfo middleware.UserInfo, exportID string) (*dto.PetExportItem, error) {
+	export, err := s.getOwnExport(userInfo, exportID)
+	if err != nil {
+		return nil, err
+	}
+
+	item := toPetExportItem(export)
+	return &item, nil
+}
+
+func (s *exportService) DownloadExport(userInfo middleware.UserInfo, exportID string) (*PetArchive, error) {
+	export, err := s.getOwnExport(userInfo, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if export.Status != utils.ExportStatusReady {
+		return nil, errors.New(utils.ExportNotReady)
+	}
+
+	return &PetArchive{
+		FileName: export.FileName,
+		Write:    copyArchive(s.store, export.ObjectName),
+	}, nil
+}
+
+// getOwnExport loads an export requested by the user. Expired archives are removed
+// from storage on first access, if the cleanup job hasn't got to them yet, and
+// reported as missing from then on.
+func (s *exportService) getOwnExport(userInfo middleware.UserInfo, exportID string) (*models.PetExport, error) {
+	export, err := s.petRepo.GetExportByID(exportID)
+	if err != nil || export.UserID != userInfo.UserID {
+		return nil, errors.New(utils.ExportNotExist)
+	}
+
+	if export.ExpiresAt != nil && time.Now().After(*export.ExpiresAt) {
+		s.removeExport(export)
+		return nil, errors.New(utils.ExportNotExist)
+	}
+
+	return export, nil
+}
+
+// CleanupExpiredExports removes the archives of exports past EXPORT_RETENTION_HOURS that
+// nobody downloaded since. It runs on EXPORT_CLEANUP_CRON; anything beyond one batch is
+// left for the next run.
+func (s *exportService) CleanupExpiredExports() {
+	exports, err := s.petRepo.GetExpiredExports(time.Now(), exportCleanupBatch)
+	if err != nil {
+		log.Printf("Export cleanup: failed to load expired exports: %v", err)
+		return
+	}
+	for i := range exports {
+		s.removeExport(&exports[i])
+	}
+	if len(exports) > 0 {
+		log.Printf("Export cleanup: removed %d expired exports", len(exports))
+	}
+}
+
+// removeExport deletes an expired export's archive and deactivates the export. It is
+// kept if the archive can't be deleted, so the next cleanup tries again.
+func (s *exportService) removeExport(export *models.PetExport) {
+	if export.ObjectName != "" {
+		if err := s.store.Delete(export.ObjectName); err != nil {
+			log.Printf("Failed to remove expired export %s: %v", export.ID, err)
+			return
+		}
+	}
+	now := time.Now()
+	export.IsActive = false
+	export.UpdatedAt = &now
+	if err := s.petRepo.UpdateExport(export); err != nil {
+		log.Printf("Failed to deactivate expired export %s: %v", export.ID, err)
+	}
+}
+
+// buildManifest loads everything recorded about the pet except the files themselves
+func (s *exportService) buildManifest(pet *models.Pet) (*dto.PetExportManifest, error) {
+	events, err := s.petRepo.GetLifeEventsByPetID(pet.ID)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := s.userRepo.GetCommentsByPetID(pet.ID)
+	if err != nil {
+		return nil, err
+	}
+	vaccinations, err := s.medicalRepo.GetVaccinationsByPetID(pet.ID)
+	if err != nil {
+		return nil, err
+	}
+	treatments, err := s.medicalRepo.GetTreatmentsByPetID(pet.ID)
+	if err != nil {
+		return nil, err
+	}
+	medications, err := s.medicalRepo.GetMedicationsByPetID(pet.ID)
+	if err != nil {
+		return nil, err
+	}
+	measurements, err := s.petRepo.GetMeasurementsByPetID(pet.ID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	tributes, err := s.petRepo.GetTributesByPetID(pet.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &dto.PetExportManifest{
+		ExportedAt: time.Now().Format("2006-01-02 15:04:05"),
+		Pet:        toPetResponse(s.store, pet, nil),
+		LifeEvents: make([]dto.PetLifeEventItem, 0, len(events)),
+		Comments:   make([]dto.CommentResponse, 0, len(comments)),
+		MedicalRecords: &dto.MedicalRecordsResponse{
+			PetID:        pet.ID,
+			Vaccinations: make([]dto.VaccinationItem, 0, len(vaccinations)),
+			Treatments:   make([]dto.TreatmentItem, 0, len(treatments)),
+			Medications:  make([]dto.MedicationItem, 0, len(medications)),
+		},
+		Measurements: make([]dto.MeasurementItem, 0, len(measurements)),
+		Appointments: []dto.AppointmentItem{},
+		Tributes:     make([]dto.TributeItem, 0, len(tributes)),
+		Files:        []string{},
+	}
+
+	// Appointments have no pet of their own; export the ones the pet's records point at
+	var appointmentIDs []string
+	seen := make(map[string]bool)
+	addAppointment := func(id string) {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			appointmentIDs = append(appointmentIDs, id)
+		}
+	}
+
+	for i := range events {
+		manifest.LifeEvents = append(manifest.LifeEvents, toPetLifeEventItem(&events[i]))
+	}
+	for _, r := range comments {
+		manifest.Comments = append(manifest.Comments, toCommentResponse(r))
+	}
+	for i := range vaccinations {
+		manifest.MedicalRecords.Vaccinations = append(manifest.MedicalRecords.Vaccinations, toVaccinationItem(&vaccinations[i]))
+		addAppointment(vaccinations[i].AppointmentID)
+	}
+	for i := range treatments {
+		manifest.MedicalRecords.Treatments = append(manifest.MedicalRecords.Treatments, toTreatmentItem(&treatments[i]))
+		addAppointment(treatments[i].AppointmentID)
+	}
+	for i := range medications {
+		manifest.MedicalRecords.Medications = append(manifest.MedicalRecords.Medications, toMedicationItem(&medications[i]))
+		addAppointment(medications[i].AppointmentID)
+	}
+	for i := range measurements {
+		manifest.Measurements = append(manifest.Measurements, toMeasurementItem(&measurements[i]))
+		addAppointment(measurements[i].AppointmentID)
+	}
+	for i := range tributes {
+		manifest.Tributes = append(manifest.Tributes, toTributeItem(&tributes[i]))
+	}
+
+	appointments, err := s.medicalRepo.GetAppointmentsByIDs(appointmentIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range appointments {
+		manifest.Appointments = append(manifest.Appointments, toAppointmentItem(&appointments[i]))
+	}
+
+	return manifest, nil
+}
+
+// runExport builds the archive in a temporary file, uploads it and notifies the requester
+func (s *exportService) runExport(export *models.PetExport, petName string, manifest *dto.PetExportManifest, photos []archivePhoto) {
+	export.Status = utils.ExportStatusRunning
+	s.saveExport(export)
+
+	size, err := s.buildExportObject(export, manifest, photos)
+	now := time.Now()
+	export.CompletedAt = &now
+	export.UpdatedAt = &now
+
+	if err != nil {
+		log.Printf("Export %s for pet %s failed: %v", export.ID, export.PetID, err)
+		export.Status = utils.ExportStatusFailed
+		export.Error = err.Error()
+		s.saveExport(export)
+		return
+	}
+
+	expiresAt := now.Add(time.Duration(config.AppConfig.ExportRetentionHours) * time.Hour)
+	export.Status = utils.ExportStatusReady
+	export.SizeBytes = size
+	export.ExpiresAt = &expiresAt
+	s.saveExport(export)
+
+	_ = s.notificationService.Notify(export.UserID, utils.NotificationExportReady,
+		fmt.Sprintf("Your export of %s is ready", petName),
+		fmt.Sprintf("The download link is available until %s.", expiresAt.Format("2006-01-02 15:04")),
+		exportDownloadLink(export.ID))
+}
+
+func (s *exportService) buildExportObject(export *models.PetExport, manifest *dto.PetExportManifest, photos []archivePhoto) (int64, error) {
+	file, err := os.CreateTemp("", "pet-export-*.zip")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	if err := writePetExport(s.store, file, manifest, photos); err != nil {
+		return 0, err
+	}
+
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	objectName := "exports/" + export.ID + ".zip"
+	if err := s.store.Put(objectName, file, size, "application/zip"); err != nil {
+		return 0, err
+	}
+	export.ObjectName = objectName
+
+	return size, nil
+}
+
+// FailStaleExports fails exports lost to a restart, see BACKGROUND_JOB_TIMEOUT_MINUTES.
+// An export that is merely slow is failed too, but still saves its result when it finishes.
+func (s *exportService) FailStaleExports() {
+	count, err := s.petRepo.FailStaleExports(time.Now().Add(-config.AppConfig.BackgroundJobTimeout), utils.ExportInterrupted)
+	if err != nil {
+		log.Printf("Job sweep: failed to check exports: %v", err)
+		return
+	}
+	if count > 0 {
+		log.Printf("Job sweep: marked %d interrupted exports failed", count)
+	}
+}
+
+func (s *exportService) saveExport(export *models.PetExport) {
+	now := time.Now()
+	export.UpdatedAt = &now
+	if err := s.petRepo.UpdateExport(export); err != nil {
+		log.Printf("Failed to update export %s: %v", export.ID, err)
+	}
+}
+
+// writePetExport writes the gallery files, then manifest.json listing the files that made it in
+func writePetExport(store storage.BlobStore, w io.Writer, manifest *dto.PetExportManifest, photos []archivePhoto) error {
+	archive := zip.NewWriter(w)
+
+	files, err := writeArchivePhotos(store, archive, "gallery", photos)
+	if err != nil {
+		return err
+	}
+	manifest.Files = append(manifest.Files, files...)
+
+	if err := writeArchiveJSON(archive, "manifest.json", manifest); err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
+
+func exportDownloadLink(exportID string) string {
+	return "/pet-exports/" + exportID + "/download"
+}
+
+func toPetExportItem(export *models.PetExport) dto.PetExportItem {
+	item := dto.PetExportItem{
+		ID:          export.ID,
+		PetID:       export.PetID,
+		Status:      export.Status,
+		FileName:    export.FileName,
+		SizeBytes:   export.SizeBytes,
+		Error:       export.Error,
+		CreatedAt:   export.CreatedAt.Format("2006-01-02 15:04:05"),
+		CompletedAt: formatOptionalDateTime(export.CompletedAt),
+		ExpiresAt:   formatOptionalDateTime(export.ExpiresAt),
+	}
+	if export.Status == utils.ExportStatusReady {
+		item.DownloadURL = exportDownloadLink(export.ID)
+	}
+	return item
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/storage"
+	"pet-service/utils"
+	"strings"
+	"time"
+)
+
+type feedService struct {
+	feedRepo repository.IFeedRepository
+	petRepo  repository.IPetRepository
+	store    storage.BlobStore
+}
+
+// NewFeedService creates a new feed service instance
+func NewFeedService(feedRepo repository.IFeedRepository, petRepo repository.IPetRepository, store storage.BlobStore) IFeedService {
+	return &feedService{
+		feedRepo: feedRepo,
+		petRepo:  petRepo,
+		store:    store,
+	}
+}
+
+func (s *feedService) FollowPet(userInfo middleware.UserInfo, petID string) (*dto.FollowResponse, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil || (pet.Visibility == utils.VisibilityPrivate && petAccessLevel(&userInfo, pet, false) == petAccessNone) {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+
+	// Followers-only pets need the owner to approve new followers; co-owners are let in directly
+	status := utils.FollowStatusApproved
+	if pet.Visibility == utils.VisibilityFollowers && petAccessLevel(&userInfo, pet, false) == petAccessNone {
+		status = utils.FollowStatusPending
+	}
+
+	follow, err := s.feedRepo.GetFollow(userInfo.UserID, petID)
+	if err != nil {
+		follow = &models.PetFollow{
+			UserID: userInfo.UserID,
+			PetID:  petID,
+			Status: status,
+		}
+		follow.CreatedBy = userInfo.UserID
+		if err := s.feedRepo.CreateFollow(follow); err != nil {
+			return nil, err
+		}
+	} else if !follow.IsActive {
+		// Re-follow reuses the row kept by the unique (user_id, pet_id) index
+		now := time.Now()
+		follow.IsActive = true
+		follow.Status = status
+		follow.UpdatedAt = &now
+		follow.UpdatedBy = userInfo.UserID
+		if err := s.feedRepo.UpdateFollow(follow); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.followResponse(petID, true, follow.Status)
+}
+
+func (s *feedService) UnfollowPet(userInfo middleware.UserInfo, petID string) (*dto.FollowResponse, error) {
+	if _, err := s.petRepo.GetPetByID(petID); err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+
+	follow, err := s.feedRepo.GetFollow(userInfo.UserID, petID)
+	if err == nil && follow.IsActive {
+		if err := s.deactivateFollow(follow, userInfo.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.followResponse(petID, false, "")
+}
+
+func (s *feedService) GetFollowRequests(userInfo middleware.UserInfo, petID string) ([]dto.FollowRequestItem, error) {
+	if err := s.checkPetOwner(userInfo, petID); err != nil {
+		return nil, err
+	}
+
+	results, err := s.feedRepo.GetPendingFollows(petID)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := []dto.FollowRequestItem{}
+	for _, r := range results {
+		request := dto.FollowRequestItem{
+			UserID: r["user_id"].(string),
+		}
+		if firstName, ok := r["first_name"].(string); ok {
+			request.FirstName = firstName
+		}
+		if lastName, ok := r["last_name"].(string); ok {
+			request.LastName = lastName
+		}
+		if avatarURL, ok := r["avatar_url"].(string); ok {
+			request.AvatarURL = avatarURL
+		}
+		if requestedAt, ok := r["updated_at"].(time.Time); ok {
+			request.RequestedAt = requestedAt.Format("2006-01-02 15:04:05")
+		} else if requestedAt, ok := r["created_at"].(time.Time); ok {
+			request.RequestedAt = requestedAt.Format("2006-01-02 15:04:05")
+		}
+		requests = append(requests, request)
+	}
+
+	return requests, nil
+}
+
+func (s *feedService) ApproveFollowRequest(userInfo middleware.UserInfo, petID, followerID string) (*dto.FollowResponse, error) {
+	if err := s.checkPetOwner(userInfo, petID); err != nil {
+		return nil, err
+	}
+
+	follow, err := s.feedRepo.GetFollow(followerID, petID)
+	if err != nil || !follow.IsActive || follow.Status != utils.FollowStatusPending {
+		return nil, errors.New(utils.FollowRequestNotExist)
+	}
+
+	now := time.Now()
+	follow.Status = utils.FollowStatusApproved
+	follow.UpdatedAt = &now
+	follow.UpdatedBy = userInfo.UserID
+	if err := s.feedRepo.UpdateFollow(follow); err != nil {
+		return nil, err
+	}
+
+	return s.followResponse(petID, true, follow.Status)
+}
+
+func (s *feedService) RejectFollowRequest(userInfo middleware.UserInfo, petID, followerID string) (*dto.MessageResponse, error) {
+	if err := s.checkPetOwner(userInfo, petID); err != nil {
+		return nil, err
+	}
+
+	follow, err := s.feedRepo.GetFollow(followerID, petID)
+	if err != nil || !follow.IsActive || follow.Status != utils.FollowStatusPending {
+		return nil, errors.New(utils.FollowRequestNotExist)
+	}
+
+	if err := s.deactivateFollow(follow, userInfo.UserID); err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Follow request rejected",
+	}, nil
+}
+
+func (s *feedService) checkPetOwner(userInfo middleware.UserInfo, petID string) error {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) != petAccessOwner {
+		return errors.New(utils.PermissionDenied)
+	}
+	return nil
+}
+
+func (s *feedService) deactivateFollow(follow *models.PetFollow, userID string) error {
+	now := time.Now()
+	follow.IsActive = false
+	follow.UpdatedAt = &now
+	follow.UpdatedBy = userID
+	return s.feedRepo.UpdateFollow(follow)
+}
+
+func (s *feedService) followResponse(petID string, following bool, status string) (*dto.FollowResponse, error) {
+	count, err := s.feedRepo.CountFollowers(petID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.FollowResponse{
+		PetID:          petID,
+		Following:      following,
+		Status:         status,
+		FollowersCount: count,
+	}, nil
+}
+
+func (s *feedService) GetFeed(userInfo middleware.UserInfo, cursor string, limit int) (*dto.FeedResponse, error) {
+	if limit <= 0 {
+		limit = utils.FeedDefaultLimit
+	}
+	if limit > utils.FeedMaxLimit {
+		limit = utils.FeedMaxLimit
+	}
+
+	var before *time.Time
+	var beforeID string
+	if cursor != "" {
+		t, id, err := utils.DecodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		before = &t
+		beforeID = id
+	}
+
+	// Fetch one extra row to know whether another page exists
+	results, err := s.feedRepo.GetFeed(userInfo.UserID, before, beforeID, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(results) > limit
+	if hasMore {
+		results = results[:limit]
+	}
+
+	response := dto.FeedResponse{
+		Data: []dto.FeedItem{},
+	}
+
+	var lastOccurredAt time.Time
+	for _, r := range results {
+		item := dto.FeedItem{
+			ID:      r["id"].(string),
+			Kind:    r["kind"].(string),
+			RefID:   r["ref_id"].(string),
+			PetID:   r["pet_id"].(string),
+			PetName: r["pet_name"].(string),
+		}
+		if summary, ok := r["summary"].(string); ok {
+			item.Summary = summary
+		}
+		visibility, _ := r["pet_visibility"].(string)
+		if key, ok := r["media_url"].(string); ok {
+			item.MediaURL = mediaURL(s.store, key, visibility)
+		}
+		if key, ok := r["pet_avt_url"].(string); ok {
+			item.PetAvtURL = mediaURL(s.store, key, visibility)
+		}
+		if actorID, ok := r["actor_id"].(string); ok {
+			item.ActorID = actorID
+		}
+		firstName, _ := r["actor_first_name"].(string)
+		lastName, _ := r["actor_last_name"].(string)
+		item.ActorName = strings.TrimSpace(firstName + " " + lastName)
+		if occurredAt, ok := r["occurred_at"].(time.Time); ok {
+			item.OccurredAt = occurredAt.Format("2006-01-02 15:04:05")
+			lastOccurredAt = occurredAt
+		}
+		response.Data = append(response.Data, item)
+	}
+
+	if hasMore && len(response.Data) > 0 {
+		response.NextCursor = utils.EncodeCursor(lastOccurredAt, response.Data[len(response.Data)-1].ID)
+	}
+
+	return &response, nil
+}
+
+// recordActivity stores a feed entry for a pet. Failures are logged rather than
+// returned so the primary write is never rolled back because of the feed.
+func recordActivity(feedRepo repository.IFeedRepository, actorID, petID, kind, refID, summary string, occurredAt time.Time) {
+	activity := &models.PetActivity{
+		PetID:      petID,
+		Kind:       kind,
+		RefID:      refID,
+		Summary:    utils.TruncateString(summary, 255),
+		OccurredAt: occurredAt,
+	}
+	activity.CreatedBy = actorID
+
+	if err := feedRepo.CreateActivity(activity); err != nil {
+		log.Printf("Failed to record %s activity for pet %s: %v", kind, petID, err)
+	}
+}
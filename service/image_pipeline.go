@@ -0,0 +1,99 @@
+package service
+
+import (
+	"bytes"
+	"log"
+	"pet-service/config"
+	"pet-service/models"
+	"pet-service/storage"
+	"pet-service/utils"
+	"sync"
+)
+
+// Decoded photos take a lot of memory, so only a few are processed at once
+var (
+	imageSlotsOnce sync.Once
+	imageSlots     chan struct{}
+)
+
+func acquireImageSlot() func() {
+	imageSlotsOnce.Do(func() {
+		imageSlots = make(chan struct{}, max(1, config.AppConfig.ImageProcessingConcurrency))
+	})
+	imageSlots <- struct{}{}
+	return func() { <-imageSlots }
+}
+
+// petObjectPrefix is where all pets' files are stored
+const petObjectPrefix = "pet/"
+
+// petObjectName is the storage key of a pet's file
+func petObjectName(petID, objectID string) string {
+	return petObjectPrefix + petID + "/" + objectID
+}
+
+// incomingObjectName is where an uploaded photo waits, unprocessed, until the pipeline
+// has stripped it. Its key is never handed out.
+func incomingObjectName(objectID string) string {
+	return "incoming/" + objectID
+}
+
+// imageVariantObjectName is the key of a resized copy: media/<hash>-thumb.jpg
+func imageVariantObjectName(objectName, variant string) string {
+	return objectName + "-" + variant + ".jpg"
+}
+
+// uploadIncomingImage stores an uploaded photo for the pipeline and returns its object ID
+func uploadIncomingImage(store storage.BlobStore, file UploadFile) (string, error) {
+	objectID := utils.GenerateUUID()
+	if err := store.Put(incomingObjectName(objectID), file.Reader, file.Size, file.ContentType); err != nil {
+		return "", err
+	}
+	return objectID, nil
+}
+
+// processIncomingImage runs the pipeline on a waiting photo and stores the result under
+// objectName. The unprocessed upload is removed whether or not processing succeeds.
+func processIncomingImage(store storage.BlobStore, objectID, objectName string) (models.ImageVariants, error) {
+	defer removeIncomingImage(store, objectID)
+
+	data, err := storage.ReadAll(store, incomingObjectName(objectID))
+	if err != nil {
+		return nil, err
+	}
+	return storeProcessedImage(store, data, objectName)
+}
+
+// removeIncomingImage deletes a photo waiting for the pipeline
+func removeIncomingImage(store storage.BlobStore, objectID string) {
+	incoming := incomingObjectName(objectID)
+	if err := store.Delete(incoming); err != nil {
+		log.Printf("Failed to remove unprocessed upload %s: %v", incoming, err)
+	}
+}
+
+// storeProcessedImage strips a photo's metadata and stores it under objectName along
+// with its resized variants, returning the object keys of the variants
+func storeProcessedImage(store storage.BlobStore, data []byte, objectName string) (models.ImageVariants, error) {
+	release := acquireImageSlot()
+	processed, err := utils.ProcessImage(data)
+	release()
+	if err != nil {
+		return nil, err
+	}
+
+	variants := make(models.ImageVariants, len(processed.Variants))
+	for name, variant := range processed.Variants {
+		key := imageVariantObjectName(objectName, name)
+		if err := store.Put(key, bytes.NewReader(variant), int64(len(variant)), "image/jpeg"); err != nil {
+			return nil, err
+		}
+		variants[name] = key
+	}
+
+	if err := store.Put(objectName, bytes.NewReader(processed.Original), int64(len(processed.Original)), processed.ContentType); err != nil {
+		return nil, err
+	}
+
+	return variants, nil
+}
@@ -0,0 +1,495 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"path"
+	"pet-service/config"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/storage"
+	"pet-service/utils"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+)
+
+type importService struct {
+	petRepo     repository.IPetRepository
+	catalogRepo repository.ICatalogRepository
+	store       storage.BlobStore
+}
+
+// NewImportService creates a new bulk pet import service instance
+func NewImportService(petRepo repository.IPetRepository, catalogRepo repository.ICatalogRepository, store storage.BlobStore) IImportService {
+	return &importService{
+		petRepo:     petRepo,
+		catalogRepo: catalogRepo,
+		store:       store,
+	}
+}
+
+// petImportRow is a parsed row plus any errors found while reading it
+type petImportRow struct {
+	data   dto.PetImportRow
+	errors []dto.ErrorDetail
+}
+
+// StartImport parses the file up front so format problems are reported right away,
+// then imports the rows into the caller's account in the background
+func (s *importService) StartImport(userInfo middleware.UserInfo, file UploadFile, req dto.PetImportRequest) (*dto.PetImportItem, error) {
+	format := importFormat(req.Format, file.Name, file.ContentType)
+	if format == "" {
+		return nil, errors.New(utils.ImportFormatInvalid)
+	}
+
+	var rows []petImportRow
+	var err error
+	if format == utils.ImportFormatCSV {
+		rows, err = parseImportCSV(file.Reader)
+	} else {
+		rows, err = parseImportJSON(file.Reader)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, errors.New(utils.ImportFileEmpty)
+	}
+	if len(rows) > utils.ImportMaxRows {
+		return nil, errors.New(utils.ImportTooManyRows)
+	}
+
+	petImport := &models.PetImport{
+		UserID:       userInfo.UserID,
+		FileName:     archiveFileName(file.Name),
+		Format:       format,
+		Status:       utils.ImportStatusPending,
+		DryRun:       req.DryRun,
+		FetchAvatars: req.FetchAvatars,
+		TotalRows:    len(rows),
+	}
+	petImport.CreatedBy = userInfo.UserID
+
+	if err := s.petRepo.CreateImport(petImport); err != nil {
+		return nil, err
+	}
+
+	go s.runImport(petImport, rows)
+
+	item := toPetImportItem(petImport)
+	return &item, nil
+}
+
+func (s *importService) GetImport(userInfo middleware.UserInfo, importID string) (*dto.PetImportItem, error) {
+	petImport, err := s.petRepo.GetImportByID(importID)
+	if err != nil || petImport.UserID != userInfo.UserID {
+		return nil, errors.New(utils.ImportNotExist)
+	}
+
+	item := toPetImportItem(petImport)
+	return &item, nil
+}
+
+func (s *importService) runImport(petImport *models.PetImport, rows []petImportRow) {
+	defer func() {
+		// A panic in one import must not take the server down with it
+		if r := recover(); r != nil {
+			log.Printf("Import %s panicked: %v", petImport.ID, r)
+			now := time.Now()
+			petImport.Status = utils.ImportStatusFailed
+			petImport.Error = utils.ServiceError
+			petImport.CompletedAt = &now
+			s.saveImport(petImport, nil)
+		}
+	}()
+
+	petImport.Status = utils.ImportStatusRunning
+	s.saveImport(petImport, nil)
+
+	results := make([]dto.PetImportRowResult, 0, len(rows))
+	seen := make(map[string]bool)
+
+	for i := range rows {
+		result := s.importRow(petImport, i+1, &rows[i], seen)
+		results = append(results, result)
+
+		switch result.Status {
+		case utils.ImportRowCreated, utils.ImportRowValid:
+			petImport.CreatedCount++
+		case utils.ImportRowExists:
+			petImport.ExistingCount++
+		default:
+			petImport.FailedCount++
+		}
+		petImport.ProcessedRows = i + 1
+
+		if petImport.ProcessedRows%utils.ImportProgressInterval == 0 {
+			s.saveImport(petImport, results)
+		}
+	}
+
+	now := time.Now()
+	petImport.Status = utils.ImportStatusCompleted
+	petImport.CompletedAt = &now
+	s.saveImport(petImport, results)
+}
+
+// importRow validates one row and, unless this is a dry run, creates the pet.
+// Rows whose external_id is already on one of the user's pets are left alone.
+func (s *importService) importRow(petImport *models.PetImport, number int, row *petImportRow, seen map[string]bool) dto.PetImportRowResult {
+	req := &row.data
+	req.ExternalID = strings.TrimSpace(req.ExternalID)
+	req.Name = strings.TrimSpace(req.Name)
+
+	result := dto.PetImportRowResult{
+		Row:        number,
+		ExternalID: req.ExternalID,
+		Errors:     row.errors,
+	}
+
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		result.Errors = append(result.Errors, utils.FormatValidationErrors(err)...)
+	}
+	if req.DateOfBirth != "" {
+		if date, _ := utils.ParseDateTime(req.DateOfBirth); date == nil {
+			result.Errors = append(result.Errors, dto.ErrorDetail{Field: "date_of_birth", Message: utils.InvalidDate})
+		}
+	}
+	if req.DateOfDeath != "" {
+		if date, _ := utils.ParseDateTime(req.DateOfDeath); date == nil {
+			result.Errors = append(result.Errors, dto.ErrorDetail{Field: "date_of_death", Message: utils.InvalidDate})
+		}
+	}
+
+	catalog, err := resolvePetCatalog(s.catalogRepo, req.SpeciesCode, req.Type, req.BreedCode, req.Breed)
+	if err != nil {
+		field := "species_code"
+		if err.Error() == utils.InvalidBreed {
+			field = "breed_code"
+		}
+		result.Errors = append(result.Errors, dto.ErrorDetail{Field: field, Message: err.Error()})
+	}
+
+	if req.ExternalID != "" {
+		if seen[req.ExternalID] {
+			result.Errors = append(result.Errors, dto.ErrorDetail{Field: "external_id", Message: utils.ImportDuplicateRow})
+		}
+		seen[req.ExternalID] = true
+	}
+
+	if len(result.Errors) > 0 {
+		result.Status = utils.ImportRowFailed
+		return result
+	}
+
+	if req.ExternalID != "" {
+		if existing, err := s.petRepo.GetPetByExternalID(petImport.UserID, req.ExternalID); err == nil {
+			result.Status = utils.ImportRowExists
+			result.PetID = existing.ID
+			return result
+		}
+	}
+
+	if petImport.DryRun {
+		result.Status = utils.ImportRowValid
+		return result
+	}
+
+	pet := newPet(petImport.UserID, req.PetCreateRequest, catalog)
+	pet.ExternalID = req.ExternalID
+	created, err := s.petRepo.CreateImportedPet(pet)
+	if err != nil {
+		log.Printf("Import %s row %d: failed to create pet: %v", petImport.ID, number, err)
+		result.Status = utils.ImportRowFailed
+		result.Errors = append(result.Errors, dto.ErrorDetail{Field: "row", Message: utils.ServiceError})
+		return result
+	}
+	if !created {
+		// Another import stored the same row since the check above
+		result.Status = utils.ImportRowExists
+		if existing, err := s.petRepo.GetPetByExternalID(petImport.UserID, req.ExternalID); err == nil {
+			result.PetID = existing.ID
+		}
+		return result
+	}
+	result.Status = utils.ImportRowCreated
+	result.PetID = pet.ID
+
+	// A missing avatar is reported on the row but does not undo the import
+	if petImport.FetchAvatars && req.AvatarURL != "" {
+		if err := s.importAvatar(pet, req.AvatarURL); err != nil {
+			result.Errors = append(result.Errors, dto.ErrorDetail{Field: "avatar_url", Message: err.Error()})
+		}
+	}
+
+	return result
+}
+
+func (s *importService) importAvatar(pet *models.Pet, avatarURL string) error {
+	data, _, err := fetchRemoteImage(avatarURL)
+	if err != nil {
+		return err
+	}
+
+	// Imports already run in the background, so the avatar is processed in place
+	key := petObjectName(pet.ID, utils.GenerateUUID())
+	variants, err := storeProcessedImage(s.store, data, key)
+	if err != nil {
+		log.Printf("Failed to store imported avatar for pet %s: %v", pet.ID, err)
+		return errors.New(utils.AvatarStoreFailed)
+	}
+
+	pet.AvtKey = key
+	pet.AvtVariants = variants
+	if err := s.petRepo.UpdatePet(pet); err != nil {
+		log.Printf("Failed to save imported avatar for pet %s: %v", pet.ID, err)
+		return errors.New(utils.AvatarStoreFailed)
+	}
+	return nil
+}
+
+// FailStaleImports fails imports lost to a restart, see BACKGROUND_JOB_TIMEOUT_MINUTES.
+// Running imports save their progress every few rows, so only stalled ones are caught.
+func (s *importService) FailStaleImports() {
+	count, err := s.petRepo.FailStaleImports(time.Now().Add(-config.AppConfig.BackgroundJobTimeout), utils.ImportInterrupted)
+	if err != nil {
+		log.Printf("Job sweep: failed to check imports: %v", err)
+		return
+	}
+	if count > 0 {
+		log.Printf("Job sweep: marked %d interrupted imports failed", count)
+	}
+}
+
+func (s *importService) saveImport(petImport *models.PetImport, results []dto.PetImportRowResult) {
+	if results != nil {
+		report, err := json.Marshal(results)
+		if err != nil {
+			log.Printf("Failed to encode report for import %s: %v", petImport.ID, err)
+		} else {
+			petImport.Report = string(report)
+		}
+	}
+
+	now := time.Now()
+	petImport.UpdatedAt = &now
+	if err := s.petRepo.UpdateImport(petImport); err != nil {
+		log.Printf("Failed to update import %s: %v", petImport.ID, err)
+	}
+}
+
+// importFormat picks the file format from the explicit form value, then the file
+// extension, then the uploaded content type
+func importFormat(format, fileName, contentType string) string {
+	if format != "" {
+		return format
+	}
+	switch strings.ToLower(path.Ext(fileName)) {
+	case ".csv":
+		return utils.ImportFormatCSV
+	case ".json":
+		return utils.ImportFormatJSON
+	}
+	switch {
+	case strings.Contains(contentType, "csv"):
+		return utils.ImportFormatCSV
+	case strings.Contains(contentType, "json"):
+		return utils.ImportFormatJSON
+	}
+	return ""
+}
+
+func parseImportJSON(r io.Reader) ([]petImportRow, error) {
+	var data []dto.PetImportRow
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return nil, errors.New(utils.ImportFileInvalid)
+	}
+
+	rows := make([]petImportRow, 0, len(data))
+	for _, d := range data {
+		rows = append(rows, petImportRow{data: d})
+	}
+	return rows, nil
+}
+
+// parseImportCSV reads a CSV whose header row names the columns. Unknown columns are ignored.
+func parseImportCSV(r io.Reader) ([]petImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New(utils.ImportFileInvalid)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		// Spreadsheet exports often start with a UTF-8 byte order mark
+		name = strings.TrimPrefix(name, "\ufeff")
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, errors.New(utils.ImportMissingColumn)
+	}
+
+	var rows []petImportRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.New(utils.ImportFileInvalid)
+		}
+		if len(rows) >= utils.ImportMaxRows {
+			return nil, errors.New(utils.ImportTooManyRows)
+		}
+
+		value := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		row := petImportRow{data: dto.PetImportRow{
+			ExternalID: value("external_id"),
+			AvatarURL:  value("avatar_url"),
+			PetCreateRequest: dto.PetCreateRequest{
+				Name:        value("name"),
+				DateOfBirth: value("date_of_birth"),
+				DateOfDeath: value("date_of_death"),
+				Breed:       value("breed"),
+				Description: value("description"),
+				Type:        value("type"),
+				SpeciesCode: value("species_code"),
+				BreedCode:   value("breed_code"),
+				Visibility:  value("visibility"),
+			},
+		}}
+		if gender := value("gender"); gender != "" {
+			male, ok := parseGender(gender)
+			if !ok {
+				row.errors = append(row.errors, dto.ErrorDetail{Field: "gender", Message: utils.InvalidGender})
+			}
+			row.data.Gender = male
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// parseGender maps a spreadsheet gender value onto the model's convention (true = male)
+func parseGender(value string) (bool, bool) {
+	switch strings.ToLower(value) {
+	case "male", "m":
+		return true, true
+	case "female", "f":
+		return false, true
+	}
+	male, err := strconv.ParseBool(value)
+	return male, err == nil
+}
+
+// remoteImageClient only connects to public addresses so import files can't be used
+// to reach services on the internal network
+var remoteImageClient = &http.Client{
+	Timeout: 15 * time.Second,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: 5 * time.Second,
+			Control: func(network, address string, _ syscall.RawConn) error {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return err
+				}
+				ip := net.ParseIP(host)
+				if ip == nil || !ip.IsGlobalUnicast() || ip.IsPrivate() {
+					return fmt.Errorf("address %s is not allowed", host)
+				}
+				return nil
+			},
+		}).DialContext,
+	},
+}
+
+// fetchRemoteImage downloads an image of at most ImportAvatarMaxSize bytes over http(s)
+func fetchRemoteImage(rawURL string) ([]byte, string, error) {
+	if !strings.HasPrefix(rawURL, "http://") && !strings.HasPrefix(rawURL, "https://") {
+		return nil, "", errors.New(utils.AvatarURLInvalid)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, "", errors.New(utils.AvatarURLInvalid)
+	}
+	response, err := remoteImageClient.Do(request)
+	if err != nil {
+		return nil, "", errors.New(utils.AvatarDownloadFailed)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, "", errors.New(utils.AvatarDownloadFailed)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(response.Body, utils.ImportAvatarMaxSize+1))
+	if err != nil {
+		return nil, "", errors.New(utils.AvatarDownloadFailed)
+	}
+	if len(data) > utils.ImportAvatarMaxSize {
+		return nil, "", errors.New(utils.AvatarTooLarge)
+	}
+
+	contentType := utils.SniffContentType(data)
+	if !slices.Contains(utils.ImageContentTypes, contentType) {
+		return nil, "", errors.New(utils.AvatarNotImage)
+	}
+
+	return data, contentType, nil
+}
+
+func toPetImportItem(petImport *models.PetImport) dto.PetImportItem {
+	item := dto.PetImportItem{
+		ID:            petImport.ID,
+		FileName:      petImport.FileName,
+		Format:        petImport.Format,
+		Status:        petImport.Status,
+		DryRun:        petImport.DryRun,
+		FetchAvatars:  petImport.FetchAvatars,
+		TotalRows:     petImport.TotalRows,
+		ProcessedRows: petImport.ProcessedRows,
+		CreatedCount:  petImport.CreatedCount,
+		ExistingCount: petImport.ExistingCount,
+		FailedCount:   petImport.FailedCount,
+		Error:         petImport.Error,
+		Rows:          []dto.PetImportRowResult{},
+		CreatedAt:     petImport.CreatedAt.Format("2006-01-02 15:04:05"),
+		CompletedAt:   formatOptionalDateTime(petImport.CompletedAt),
+	}
+	if petImport.Report != "" {
+		if err := json.Unmarshal([]byte(petImport.Report), &item.Rows); err != nil {
+			log.Printf("Failed to decode report for import %s: %v", petImport.ID, err)
+		}
+	}
+	return item
+}
@@ -4,36 +4,197 @@ import (
 	"io"
 	"pet-service/dto"
 	"pet-service/middleware"
+	"time"
 
 	"gorm.io/gorm"
 )
 
+// UploadFile is a client file handed from a handler to a service for storage
+type UploadFile struct {
+	Reader      io.Reader
+	Name        string
+	ContentType string
+	Size        int64
+}
+
+// IVideoProcessor inspects uploaded videos and extracts their poster frames. It is an
+// interface so the ffmpeg tools can be stubbed.
+type IVideoProcessor interface {
+	Probe(path string) (*VideoInfo, error)
+	Poster(path string, at time.Duration) ([]byte, error)
+}
+
+// PetArchive is a ZIP download built by a service and streamed by the handler
+type PetArchive struct {
+	FileName string
+	Write    func(w io.Writer) error
+}
+
 // IUserService defines the interface for user business logic operations
 type IUserService interface {
 	Register(req dto.UserRegisterRequest) (*dto.UserResponse, error)
 	Login(req dto.LoginRequest) (*dto.LoginResponse, error)
 	GetMe(userInfo middleware.UserInfo) (*dto.UserResponse, error)
 	Logout(userInfo middleware.UserInfo) (*dto.MessageResponse, error)
-	GetUsers() ([]dto.UserResponse, error)
+	GetUsers(userInfo middleware.UserInfo, query dto.PageQuery) (*dto.PaginationResponse, error)
 	ChangePassword(userInfo middleware.UserInfo, req dto.ChangePasswordRequest) (*dto.MessageResponse, error)
+	UpdatePrivacy(userInfo middleware.UserInfo, req dto.UserPrivacyRequest) (*dto.UserResponse, error)
 	
 	// Comment operations
 	CreateComment(userInfo middleware.UserInfo, petID string, req dto.CommentRequest) (*dto.CommentResponse, error)
 	EditComment(userInfo middleware.UserInfo, petID, commentID string, req dto.CommentRequest) (*dto.CommentResponse, error)
-	GetCommentsByPetID(petID string) ([]dto.CommentResponse, error)
+	GetCommentsByPetID(userInfo middleware.UserInfo, petID string, query dto.PageQuery) (*dto.PaginationResponse, error)
 }
 
 // IPetService defines the interface for pet business logic operations
 type IPetService interface {
 	CreatePet(userInfo middleware.UserInfo, req dto.PetCreateRequest) (*dto.PetResponse, error)
-	GetPets(db *gorm.DB, page, pageSize int, search, name string) (*dto.PaginationResponse, error)
-	GetPetDetail(petID string) (*dto.PetDetailResponse, error)
+	GetPets(db *gorm.DB, userInfo middleware.UserInfo, params dto.PetListQuery) (*dto.PaginationResponse, error)
+	GetPetDetail(viewer *middleware.UserInfo, petID string) (*dto.PetDetailResponse, error)
+	UpdatePet(userInfo middleware.UserInfo, petID string, req dto.PetUpdateRequest) (*dto.PetResponse, error)
+	UpdateVisibility(userInfo middleware.UserInfo, petID string, req dto.PetVisibilityRequest) (*dto.PetResponse, error)
 	CreatePetLifeEvent(userInfo middleware.UserInfo, req dto.PetLifeEventRequest) (*dto.PetLifeEventResponse, error)
-	UploadAvatar(petID string, fileData []byte, contentType string) (*dto.MediaResponse, error)
-	UploadGallery(petID string, files []io.Reader, fileNames []string, contentTypes []string) ([]dto.MediaResponse, error)
+	UploadAvatar(userInfo middleware.UserInfo, petID string, file UploadFile) (*dto.MediaResponse, error)
+	UploadGallery(userInfo middleware.UserInfo, petID string, files []UploadFile) ([]dto.MediaResponse, error)
+	CreateUploadURL(userInfo middleware.UserInfo, petID string, req dto.MediaUploadURLRequest) (*dto.MediaUploadURLResponse, error)
+	CompleteUpload(userInfo middleware.UserInfo, petID string, req dto.MediaUploadCompleteRequest) (*dto.MediaResponse, error)
+	CleanupExpiredUploads()
+	FailStaleMedias()
 }
 
 // IAppointmentService defines the interface for appointment business logic operations
 type IAppointmentService interface {
 	RegisterAppointment(userInfo middleware.UserInfo, req dto.AppointmentRequest) (*dto.AppointmentResponse, error)
+	GetAppointments(userInfo middleware.UserInfo, query dto.PageQuery) (*dto.PaginationResponse, error)
+}
+
+// IFeedService defines the interface for follow and activity feed business logic operations
+type IFeedService interface {
+	FollowPet(userInfo middleware.UserInfo, petID string) (*dto.FollowResponse, error)
+	UnfollowPet(userInfo middleware.UserInfo, petID string) (*dto.FollowResponse, error)
+	GetFeed(userInfo middleware.UserInfo, cursor string, limit int) (*dto.FeedResponse, error)
+	GetFollowRequests(userInfo middleware.UserInfo, petID string) ([]dto.FollowRequestItem, error)
+	ApproveFollowRequest(userInfo middleware.UserInfo, petID, followerID string) (*dto.FollowResponse, error)
+	RejectFollowRequest(userInfo middleware.UserInfo, petID, followerID string) (*dto.MessageResponse, error)
+}
+
+// IShareService defines the interface for public share link and QR code operations
+type IShareService interface {
+	GetShareLink(userInfo middleware.UserInfo, petID string) (*dto.ShareLinkResponse, error)
+	RotateShareLink(userInfo middleware.UserInfo, petID string) (*dto.ShareLinkResponse, error)
+	RevokeShareLink(userInfo middleware.UserInfo, petID string) (*dto.MessageResponse, error)
+	GetQRCode(userInfo middleware.UserInfo, petID string, size int) ([]byte, error)
+	GetPublicProfile(slug string) (*dto.PublicPetProfileResponse, error)
+}
+
+// INotificationService defines the interface for in-app notification operations
+type INotificationService interface {
+	Notify(userID, notificationType, title, body, link string) error
+	GetNotifications(userInfo middleware.UserInfo, unreadOnly bool) ([]dto.NotificationItem, error)
+	MarkRead(userInfo middleware.UserInfo, notificationID string) (*dto.NotificationItem, error)
+}
+
+// ILostPetService defines the interface for lost & found business logic operations
+type ILostPetService interface {
+	UpdateLostStatus(userInfo middleware.UserInfo, petID string, req dto.PetLostRequest) (*dto.LostPetItem, error)
+	GetLostPets(petType, area string, lat, lng, radiusKm *float64, page, pageSize int) (*dto.PaginationResponse, error)
+	ReportSighting(reporter *middleware.UserInfo, petID string, req dto.SightingRequest, photo *UploadFile) (*dto.SightingResponse, error)
+	GetSightings(userInfo middleware.UserInfo, petID string) ([]dto.SightingResponse, error)
+}
+
+// IMedicalService defines the interface for medical record business logic operations
+type IMedicalService interface {
+	GetMedicalRecords(userInfo middleware.UserInfo, petID string) (*dto.MedicalRecordsResponse, error)
+	CreateVaccination(userInfo middleware.UserInfo, petID string, req dto.VaccinationRequest) (*dto.VaccinationItem, error)
+	UpdateVaccination(userInfo middleware.UserInfo, petID, recordID string, req dto.VaccinationRequest) (*dto.VaccinationItem, error)
+	DeleteVaccination(userInfo middleware.UserInfo, petID, recordID string) (*dto.MessageResponse, error)
+	CreateTreatment(userInfo middleware.UserInfo, petID string, req dto.TreatmentRequest) (*dto.TreatmentItem, error)
+	UpdateTreatment(userInfo middleware.UserInfo, petID, recordID string, req dto.TreatmentRequest) (*dto.TreatmentItem, error)
+	DeleteTreatment(userInfo middleware.UserInfo, petID, recordID string) (*dto.MessageResponse, error)
+	CreateMedication(userInfo middleware.UserInfo, petID string, req dto.MedicationRequest) (*dto.MedicationItem, error)
+	UpdateMedication(userInfo middleware.UserInfo, petID, recordID string, req dto.MedicationRequest) (*dto.MedicationItem, error)
+	DeleteMedication(userInfo middleware.UserInfo, petID, recordID string) (*dto.MessageResponse, error)
+}
+
+// IMeasurementService defines the interface for weight and growth tracking operations
+type IMeasurementService interface {
+	CreateMeasurement(userInfo middleware.UserInfo, petID string, req dto.MeasurementRequest) (*dto.MeasurementItem, error)
+	DeleteMeasurement(userInfo middleware.UserInfo, petID, measurementID string) (*dto.MessageResponse, error)
+	GetMeasurements(userInfo middleware.UserInfo, petID, from, to string) ([]dto.MeasurementItem, error)
+	GetMeasurementSeries(userInfo middleware.UserInfo, petID, bucket, from, to string) (*dto.MeasurementSeriesResponse, error)
+}
+
+// IMemorialService defines the interface for memorial profile operations
+type IMemorialService interface {
+	CreateTribute(userInfo middleware.UserInfo, petID string, req dto.TributeRequest) (*dto.TributeItem, error)
+	GetTributes(userInfo middleware.UserInfo, petID string) ([]dto.TributeItem, error)
+	DeleteTribute(userInfo middleware.UserInfo, petID, tributeID string) (*dto.MessageResponse, error)
+	GetArchive(userInfo middleware.UserInfo, petID string) (*PetArchive, error)
+}
+
+// IImportService defines the interface for bulk pet import operations
+type IImportService interface {
+	StartImport(userInfo middleware.UserInfo, file UploadFile, req dto.PetImportRequest) (*dto.PetImportItem, error)
+	GetImport(userInfo middleware.UserInfo, importID string) (*dto.PetImportItem, error)
+	FailStaleImports()
+}
+
+// IExportService defines the interface for full pet data export operations
+type IExportService interface {
+	ExportPet(userInfo middleware.UserInfo, petID string, async bool) (*PetArchive, *dto.PetExportItem, error)
+	GetExport(userInfo middleware.UserInfo, exportID string) (*dto.PetExportItem, error)
+	DownloadExport(userInfo middleware.UserInfo, exportID string) (*PetArchive, error)
+	FailStaleExports()
+	CleanupExpiredExports()
+}
+
+// IMemberService defines the interface for co-owner and ownership transfer operations
+type IMemberService interface {
+	InviteMember(userInfo middleware.UserInfo, petID string, req dto.PetMemberInviteRequest) (*dto.PetMemberItem, error)
+	GetMembers(userInfo middleware.UserInfo, petID string) ([]dto.PetMemberItem, error)
+	UpdateMemberRole(userInfo middleware.UserInfo, petID, memberID string, req dto.PetMemberRoleRequest) (*dto.PetMemberItem, error)
+	RemoveMember(userInfo middleware.UserInfo, petID, memberID string) (*dto.MessageResponse, error)
+	GetInvites(userInfo middleware.UserInfo) ([]dto.PetInviteItem, error)
+	AcceptInvite(userInfo middleware.UserInfo, inviteID string) (*dto.PetMemberItem, error)
+	DeclineInvite(userInfo middleware.UserInfo, inviteID string) (*dto.MessageResponse, error)
+	TransferOwnership(userInfo middleware.UserInfo, petID string, req dto.PetTransferRequest) (*dto.PetTransferItem, error)
+	CancelTransfer(userInfo middleware.UserInfo, petID string) (*dto.MessageResponse, error)
+	GetTransfers(userInfo middleware.UserInfo) ([]dto.PetTransferItem, error)
+	AcceptTransfer(userInfo middleware.UserInfo, transferID string) (*dto.PetTransferItem, error)
+	DeclineTransfer(userInfo middleware.UserInfo, transferID string) (*dto.MessageResponse, error)
+}
+
+// IPedigreeService defines the interface for pet lineage operations
+type IPedigreeService interface {
+	SetParents(userInfo middleware.UserInfo, petID string, req dto.PetParentsRequest) (*dto.PedigreeNode, error)
+	GetPedigree(userInfo middleware.UserInfo, petID string, depth int) (*dto.PedigreeNode, error)
+	GetSiblings(userInfo middleware.UserInfo, petID string) ([]dto.PetRelativeItem, error)
+	GetOffspring(userInfo middleware.UserInfo, petID string) ([]dto.PetRelativeItem, error)
+}
+
+// ICatalogService defines the interface for species/breed catalog operations
+type ICatalogService interface {
+	GetSpecies(q, locale string, limit int) ([]dto.CatalogItem, error)
+	GetBreeds(speciesCode, q, locale string, limit int) ([]dto.CatalogItem, error)
+	CreateSpecies(userInfo middleware.UserInfo, req dto.CatalogSpeciesRequest) (*dto.CatalogItem, error)
+	CreateBreed(userInfo middleware.UserInfo, req dto.CatalogBreedRequest) (*dto.CatalogItem, error)
+	AddTerm(userInfo middleware.UserInfo, req dto.CatalogTermRequest) (*dto.CatalogItem, error)
+}
+
+// IMediaService defines the interface for gallery management operations
+type IMediaService interface {
+	DeleteMedia(userInfo middleware.UserInfo, mediaID string) (*dto.MessageResponse, error)
+	UpdateMedia(userInfo middleware.UserInfo, mediaID string, req dto.MediaUpdateRequest) (*dto.MediaItem, error)
+	ReorderMedias(userInfo middleware.UserInfo, petID string, req dto.MediaOrderRequest) (*dto.MessageResponse, error)
+	SetCover(userInfo middleware.UserInfo, petID string, req dto.PetCoverRequest) (*dto.MessageResponse, error)
+	SetAvatar(userInfo middleware.UserInfo, petID string, req dto.PetAvatarRequest) (*dto.PetResponse, error)
+	CreateAlbum(userInfo middleware.UserInfo, petID string, req dto.MediaAlbumRequest) (*dto.MediaAlbumItem, error)
+	GetAlbums(userInfo middleware.UserInfo, petID string) ([]dto.MediaAlbumItem, error)
+	UpdateAlbum(userInfo middleware.UserInfo, petID, albumID string, req dto.MediaAlbumUpdateRequest) (*dto.MediaAlbumItem, error)
+	DeleteAlbum(userInfo middleware.UserInfo, petID, albumID string) (*dto.MessageResponse, error)
+}
+
+// IStorageService defines the interface for storage maintenance jobs
+type IStorageService interface {
+	ReconcileStorage()
 }
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/storage"
+	"pet-service/utils"
+	"time"
+)
+
+const (
+	lostPetDefaultRadiusKm = 10.0
+	lostPetMaxRadiusKm     = 200.0
+	kmPerDegreeLatitude    = 111.32
+)
+
+type lostPetService struct {
+	petRepo             repository.IPetRepository
+	notificationService INotificationService
+	store               storage.BlobStore
+}
+
+// NewLostPetService creates a new lost & found service instance
+func NewLostPetService(petRepo repository.IPetRepository, notificationService INotificationService, store storage.BlobStore) ILostPetService {
+	return &lostPetService{
+		petRepo:             petRepo,
+		notificationService: notificationService,
+		store:               store,
+	}
+}
+
+func (s *lostPetService) UpdateLostStatus(userInfo middleware.UserInfo, petID string, req dto.PetLostRequest) (*dto.LostPetItem, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) < petAccessCaretaker {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	if req.IsLost && isMemorial(pet) {
+		return nil, errors.New(utils.PetIsMemorial)
+	}
+
+	now := time.Now()
+	if req.IsLost {
+		lastSeenAt := &now
+		if req.LastSeenAt != "" {
+			if t, _ := utils.ParseDateTime(req.LastSeenAt); t != nil {
+				lastSeenAt = t
+			}
+		}
+		if !pet.IsLost {
+			pet.LostAt = &now
+		}
+		pet.IsLost = true
+		pet.LastSeenAt = lastSeenAt
+		pet.LastSeenLocation = req.LastSeenLocation
+		pet.LastSeenLat = req.Latitude
+		pet.LastSeenLng = req.Longitude
+	} else {
+		clearLostState(pet)
+	}
+	pet.UpdatedAt = &now
+	pet.UpdatedBy = userInfo.UserID
+
+	if err := s.petRepo.UpdatePet(pet); err != nil {
+		return nil, err
+	}
+
+	item := toLostPetItem(s.store, pet)
+	return &item, nil
+}
+
+func (s *lostPetService) GetLostPets(petType, area string, lat, lng, radiusKm *float64, page, pageSize int) (*dto.PaginationResponse, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	if pageSize > utils.MaxPageSize {
+		pageSize = utils.MaxPageSize
+	}
+
+	var bounds *repository.GeoBounds
+	if lat != nil && lng != nil {
+		radius := lostPetDefaultRadiusKm
+		if radiusKm != nil && *radiusKm > 0 {
+			radius = math.Min(*radiusKm, lostPetMaxRadiusKm)
+		}
+		bounds = geoBoundsAround(*lat, *lng, radius)
+	}
+
+	pets, total, err := s.petRepo.GetLostPets(petType, area, bounds, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.LostPetItem, 0, len(pets))
+	for i := range pets {
+		items = append(items, toLostPetItem(s.store, &pets[i]))
+	}
+
+	return &dto.PaginationResponse{
+		Data: items,
+		Meta: pageMeta(repository.PageRequest{Page: page, Size: pageSize}, repository.PageInfo{Total: &total},
+			pets, func(pet *models.Pet) (time.Time, string) { return pet.CreatedAt, pet.ID }),
+	}, nil
+}
+
+func (s *lostPetService) ReportSighting(reporter *middleware.UserInfo, petID string, req dto.SightingRequest, photo *UploadFile) (*dto.SightingResponse, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if !pet.IsLost {
+		return nil, errors.New(utils.PetIsNotLost)
+	}
+
+	seenAt := time.Now()
+	if req.SeenAt != "" {
+		if t, _ := utils.ParseDateTime(req.SeenAt); t != nil {
+			seenAt = *t
+		}
+	}
+
+	sighting := &models.PetSighting{
+		PetID:           petID,
+		ReporterName:    req.ReporterName,
+		ReporterContact: req.ReporterContact,
+		Note:            req.Note,
+		SeenAt:          seenAt,
+		Location:        req.Location,
+		Latitude:        req.Latitude,
+		Longitude:       req.Longitude,
+	}
+	if reporter != nil {
+		sighting.ReporterID = reporter.UserID
+		sighting.CreatedBy = reporter.UserID
+		if sighting.ReporterName == "" {
+			sighting.ReporterName = reporter.FirstName + " " + reporter.LastName
+		}
+	}
+
+	if photo != nil {
+		if err := checkUpload(photo, "photo", utils.ImageContentTypes); err != nil {
+			return nil, err
+		}
+		// Reporters are often anonymous, so the photo's EXIF (GPS, device) never reaches the owner
+		data, err := io.ReadAll(photo.Reader)
+		if err != nil {
+			return nil, errors.New(utils.UploadFileUnreadable)
+		}
+		key := petObjectName(petID, utils.GenerateUUID())
+		variants, err := storeProcessedImage(s.store, data, key)
+		if err != nil {
+			log.Printf("Failed to store sighting photo for pet %s: %v", petID, err)
+			return nil, errors.New(utils.SightingPhotoFailed)
+		}
+		sighting.PhotoKey = key
+		sighting.PhotoVariants = variants
+	}
+
+	if err := s.petRepo.CreateSighting(sighting); err != nil {
+		return nil, err
+	}
+
+	body := sighting.Note
+	if sighting.Location != "" {
+		body = fmt.Sprintf("Seen near %s. %s", sighting.Location, sighting.Note)
+	}
+	_ = s.notificationService.Notify(pet.UserID, utils.NotificationLostPetSighting,
+		fmt.Sprintf("New sighting of %s", pet.Name), body, "/pet/"+pet.ID+"/sightings")
+
+	response := toSightingResponse(s.store, sighting)
+	// Reporter contact details are for the owner only
+	response.ReporterContact = ""
+	return &response, nil
+}
+
+func (s *lostPetService) GetSightings(userInfo middleware.UserInfo, petID string) ([]dto.SightingResponse, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) < petAccessCaretaker {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	sightings, err := s.petRepo.GetSightingsByPetID(petID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := []dto.SightingResponse{}
+	for i := range sightings {
+		responses = append(responses, toSightingResponse(s.store, &sightings[i]))
+	}
+
+	return responses, nil
+}
+
+// clearLostState drops the lost-mode data so the pet no longer shows in the public listing
+func clearLostState(pet *models.Pet) {
+	pet.IsLost = false
+	pet.LostAt = nil
+	pet.LastSeenAt = nil
+	pet.LastSeenLocation = ""
+	pet.LastSeenLat = nil
+	pet.LastSeenLng = nil
+}
+
+// geoBoundsAround approximates a circle of radiusKm as a lat/lng bounding box
+func geoBoundsAround(lat, lng, radiusKm float64) *repository.GeoBounds {
+	latDelta := radiusKm / kmPerDegreeLatitude
+	lngDelta := radiusKm / (kmPerDegreeLatitude * math.Max(math.Cos(lat*math.Pi/180), 0.01))
+
+	return &repository.GeoBounds{
+		MinLat: lat - latDelta,
+		MaxLat: lat + latDelta,
+		MinLng: lng - lngDelta,
+		MaxLng: lng + lngDelta,
+	}
+}
+
+func toLostPetItem(store storage.BlobStore, pet *models.Pet) dto.LostPetItem {
+	item := dto.LostPetItem{
+		ID:               pet.ID,
+		Name:             pet.Name,
+		Type:             pet.Type,
+		Breed:            pet.Breed,
+		Gender:           pet.Gender,
+		AvtURL:           mediaURL(store, pet.AvtKey, pet.Visibility),
+		LastSeenLocation: pet.LastSeenLocation,
+		Latitude:         pet.LastSeenLat,
+		Longitude:        pet.LastSeenLng,
+	}
+	if pet.LostAt != nil {
+		item.LostAt = pet.LostAt.Format("2006-01-02 15:04:05")
+	}
+	if pet.LastSeenAt != nil {
+		item.LastSeenAt = pet.LastSeenAt.Format("2006-01-02 15:04:05")
+	}
+	return item
+}
+
+func toSightingResponse(store storage.BlobStore, sighting *models.PetSighting) dto.SightingResponse {
+	return dto.SightingResponse{
+		ID:              sighting.ID,
+		PetID:           sighting.PetID,
+		Note:            sighting.Note,
+		SeenAt:          sighting.SeenAt.Format("2006-01-02 15:04:05"),
+		Location:        sighting.Location,
+		Latitude:        sighting.Latitude,
+		Longitude:       sighting.Longitude,
+		PhotoURL:        mediaURL(store, sighting.PhotoKey, utils.VisibilityPrivate),
+		PhotoVariants:   mediaVariantURLs(store, sighting.PhotoVariants, utils.VisibilityPrivate),
+		ReporterName:    sighting.ReporterName,
+		ReporterContact: sighting.ReporterContact,
+		CreatedAt:       sighting.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"errors"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/utils"
+	"strings"
+	"time"
+)
+
+type measurementService struct {
+	petRepo     repository.IPetRepository
+	medicalRepo repository.IMedicalRepository
+	userRepo    repository.IUserRepository
+}
+
+// NewMeasurementService creates a new growth tracking service instance
+func NewMeasurementService(petRepo repository.IPetRepository, medicalRepo repository.IMedicalRepository, userRepo repository.IUserRepository) IMeasurementService {
+	return &measurementService{
+		petRepo:     petRepo,
+		medicalRepo: medicalRepo,
+		userRepo:    userRepo,
+	}
+}
+
+func (s *measurementService) CreateMeasurement(userInfo middleware.UserInfo, petID string, req dto.MeasurementRequest) (*dto.MeasurementItem, error) {
+	if req.WeightKg == nil && req.BodyConditionScore == nil && req.HeightCm == nil {
+		return nil, errors.New(utils.MeasurementEmpty)
+	}
+
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+
+	household := petAccessLevel(&userInfo, pet, false) >= petAccessCaretaker
+	staff := isStaffEditor(s.userRepo, userInfo)
+	if !household && !staff {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	// Readings taken during an appointment can only come from clinic staff
+	if req.AppointmentID != "" {
+		if !staff {
+			return nil, errors.New(utils.PermissionDenied)
+		}
+		if !s.medicalRepo.AppointmentExists(req.AppointmentID) {
+			return nil, errors.New(utils.AppointmentNotExist)
+		}
+	}
+
+	measuredAt := time.Now()
+	if req.MeasuredAt != "" {
+		if measuredAt, err = parseRequiredDate(req.MeasuredAt); err != nil {
+			return nil, err
+		}
+	}
+
+	measurement := &models.PetMeasurement{
+		PetID:              petID,
+		MeasuredAt:         measuredAt,
+		WeightKg:           req.WeightKg,
+		BodyConditionScore: req.BodyConditionScore,
+		HeightCm:           req.HeightCm,
+		Notes:              req.Notes,
+		AppointmentID:      req.AppointmentID,
+	}
+	if staff && (req.AppointmentID != "" || !household) {
+		staffID := userInfo.UserID
+		measurement.StaffID = &staffID
+	}
+	measurement.CreatedBy = userInfo.UserID
+
+	if err := s.petRepo.CreateMeasurement(measurement); err != nil {
+		return nil, err
+	}
+
+	item := toMeasurementItem(measurement)
+	if measurement.StaffID != nil {
+		item.StaffName = userInfo.FirstName + " " + userInfo.LastName
+	}
+	return &item, nil
+}
+
+func (s *measurementService) DeleteMeasurement(userInfo middleware.UserInfo, petID, measurementID string) (*dto.MessageResponse, error) {
+	if _, err := s.checkAccess(userInfo, petID, true); err != nil {
+		return nil, err
+	}
+
+	measurement, err := s.petRepo.GetMeasurementByID(measurementID)
+	if err != nil || measurement.PetID != petID {
+		return nil, errors.New(utils.MeasurementNotExist)
+	}
+
+	now := time.Now()
+	measurement.IsActive = false
+	measurement.UpdatedAt = &now
+	measurement.UpdatedBy = userInfo.UserID
+
+	if err := s.petRepo.UpdateMeasurement(measurement); err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{Message: "Measurement deleted"}, nil
+}
+
+func (s *measurementService) GetMeasurements(userInfo middleware.UserInfo, petID, from, to string) ([]dto.MeasurementItem, error) {
+	if _, err := s.checkAccess(userInfo, petID, false); err != nil {
+		return nil, err
+	}
+
+	fromTime, toTime, err := parseDateRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	measurements, err := s.petRepo.GetMeasurementsByPetID(petID, fromTime, toTime)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.MeasurementItem, 0, len(measurements))
+	for i := range measurements {
+		items = append(items, toMeasurementItem(&measurements[i]))
+	}
+
+	return items, nil
+}
+
+func (s *measurementService) GetMeasurementSeries(userInfo middleware.UserInfo, petID, bucket, from, to string) (*dto.MeasurementSeriesResponse, error) {
+	pet, err := s.checkAccess(userInfo, petID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket == "" {
+		bucket = utils.MeasurementBucketMonth
+	}
+	if bucket != utils.MeasurementBucketWeek && bucket != utils.MeasurementBucketMonth {
+		return nil, errors.New(utils.InvalidBucket)
+	}
+
+	fromTime, toTime, err := parseDateRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.petRepo.GetMeasurementSeries(petID, bucket, fromTime, toTime)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.MeasurementSeriesResponse{
+		PetID:  petID,
+		Bucket: bucket,
+		Series: make([]dto.MeasurementBucket, 0, len(rows)),
+	}
+	for _, row := range rows {
+		response.Series = append(response.Series, dto.MeasurementBucket{
+			Start:         row.Bucket.Format("2006-01-02"),
+			Count:         row.Count,
+			WeightKg:      toMetricStats(row.MinWeight, row.MaxWeight, row.AvgWeight),
+			BodyCondition: toMetricStats(row.MinBcs, row.MaxBcs, row.AvgBcs),
+			HeightCm:      toMetricStats(row.MinHeight, row.MaxHeight, row.AvgHeight),
+		})
+	}
+
+	if strings.TrimSpace(pet.Type) != "" && strings.TrimSpace(pet.Breed) != "" {
+		if reference, err := s.petRepo.GetBreedReferenceRange(pet.Type, pet.Breed); err == nil {
+			response.ReferenceRange = &dto.BreedReferenceRangeItem{
+				Type:                  reference.Type,
+				Breed:                 reference.Breed,
+				MinWeightKg:           reference.MinWeightKg,
+				MaxWeightKg:           reference.MaxWeightKg,
+				MinHeightCm:           reference.MinHeightCm,
+				MaxHeightCm:           reference.MaxHeightCm,
+				MinBodyConditionScore: reference.MinBodyConditionScore,
+				MaxBodyConditionScore: reference.MaxBodyConditionScore,
+			}
+		}
+	}
+
+	return response, nil
+}
+
+// checkAccess allows the pet's owners and caretakers, and clinic staff: any staff to
+// read, only those who can edit pets to write
+func (s *measurementService) checkAccess(userInfo middleware.UserInfo, petID string, write bool) (*models.Pet, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) >= petAccessCaretaker {
+		return pet, nil
+	}
+	staff := isStaff(s.userRepo, userInfo)
+	if write {
+		staff = isStaffEditor(s.userRepo, userInfo)
+	}
+	if !staff {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+	return pet, nil
+}
+
+// parseDateRange parses optional from/to bounds; to is inclusive of its whole day
+func parseDateRange(from, to string) (*time.Time, *time.Time, error) {
+	fromTime, err := parseOptionalDate(from)
+	if err != nil {
+		return nil, nil, err
+	}
+	toTime, err := parseOptionalDate(to)
+	if err != nil {
+		return nil, nil, err
+	}
+	if toTime != nil && len(to) == len("2006-01-02") {
+		end := toTime.AddDate(0, 0, 1)
+		toTime = &end
+	}
+	return fromTime, toTime, nil
+}
+
+func toMetricStats(min, max, avg *float64) *dto.MetricStats {
+	if min == nil || max == nil || avg == nil {
+		return nil
+	}
+	return &dto.MetricStats{Min: *min, Max: *max, Avg: *avg}
+}
+
+func toMeasurementItem(m *models.PetMeasurement) dto.MeasurementItem {
+	item := dto.MeasurementItem{
+		ID:                 m.ID,
+		PetID:              m.PetID,
+		MeasuredAt:         m.MeasuredAt.Format("2006-01-02 15:04:05"),
+		WeightKg:           m.WeightKg,
+		BodyConditionScore: m.BodyConditionScore,
+		HeightCm:           m.HeightCm,
+		Notes:              m.Notes,
+		AppointmentID:      m.AppointmentID,
+	}
+	if m.StaffID != nil {
+		item.StaffID = *m.StaffID
+	}
+	if m.Staff != nil {
+		item.StaffName = strings.TrimSpace(m.Staff.FirstName + " " + m.Staff.LastName)
+	}
+	return item
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"pet-service/dto"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/storage"
+	"pet-service/utils"
+)
+
+// mediaBlobPrefix is where gallery files are stored, once per content
+const mediaBlobPrefix = "media/"
+
+// mediaBlobObjectName is the storage key of a gallery file with the given SHA-256: media/<hash>
+func mediaBlobObjectName(hash string) string {
+	return mediaBlobPrefix + hash
+}
+
+// hashUpload hashes the file as it is read; the returned function gives the hex SHA-256
+// once the reader has been drained
+func hashUpload(file *UploadFile) func() string {
+	hash := sha256.New()
+	file.Reader = io.TeeReader(file.Reader, hash)
+	return func() string {
+		return hex.EncodeToString(hash.Sum(nil))
+	}
+}
+
+// removeMediaBlob deletes a blob whose last reference has been released, and its objects.
+// Objects that can't be deleted are logged and left for the storage reconciliation job.
+func removeMediaBlob(mediaRepo repository.IMediaRepository, store storage.BlobStore, hash string) {
+	err := mediaRepo.RemoveMediaBlob(hash, func(blob *models.MediaBlob) {
+		objects := []string{blob.Key}
+		for _, key := range blob.Variants {
+			objects = append(objects, key)
+		}
+		for _, object := range objects {
+			if err := store.Delete(object); err != nil {
+				log.Printf("Failed to remove %s of unreferenced blob %s: %v", object, blob.Hash, err)
+			}
+		}
+	})
+	if err != nil {
+		log.Printf("Failed to remove unreferenced blob %s: %v", hash, err)
+	}
+}
+
+// failProcessingMedia marks a gallery item whose processing won't finish failed, removing
+// its video's blob if nothing else holds it. It reports whether the item was failed.
+func failProcessingMedia(mediaRepo repository.IMediaRepository, store storage.BlobStore, media *models.Media) bool {
+	failed, unreferenced, err := mediaRepo.FailProcessingMedia(media)
+	if err != nil {
+		log.Printf("Failed to mark gallery item %s failed: %v", media.ID, err)
+		return false
+	}
+	if unreferenced {
+		removeMediaBlob(mediaRepo, store, media.ContentHash)
+	}
+	return failed
+}
+
+// acquireMediaBlob takes a reference to the blob of a gallery file, creating it if the
+// file hasn't been stored before. Its objects only exist once it is ready.
+func (s *petService) acquireMediaBlob(hash string) (*models.MediaBlob, error) {
+	blob := &models.MediaBlob{Hash: hash, Key: mediaBlobObjectName(hash)}
+	if err := s.mediaRepo.AcquireMediaBlob(blob); err != nil {
+		return nil, err
+	}
+	return blob, nil
+}
+
+// releaseMediaBlob gives back a reference taken for a file that didn't make it into the gallery
+func (s *petService) releaseMediaBlob(hash string) {
+	unreferenced, err := s.mediaRepo.ReleaseMediaBlob(hash)
+	if err != nil {
+		log.Printf("Failed to release blob %s: %v", hash, err)
+		return
+	}
+	if unreferenced {
+		removeMediaBlob(s.mediaRepo, s.store, hash)
+	}
+}
+
+// storeGalleryVideo attaches a staged video to the blob of its content and uploads it,
+// unless the same file is stored already. It reports whether the poster frame still has
+// to be extracted; if not, the item is ready and shares the stored poster.
+func (s *petService) storeGalleryVideo(media *models.Media, video *stagedVideo, size int64, contentType string) (bool, error) {
+	blob, err := s.acquireMediaBlob(video.hash)
+	if err != nil {
+		return false, err
+	}
+
+	media.ContentHash = video.hash
+	media.Key = blob.Key
+	media.Duration = video.info.Duration.Seconds()
+	media.Width, media.Height = video.info.Width, video.info.Height
+	if blob.Ready {
+		media.Variants = blob.Variants
+		media.Status = utils.MediaStatusReady
+		return false, nil
+	}
+
+	if err := s.store.Put(blob.Key, video.file, size, contentType); err != nil {
+		s.releaseMediaBlob(video.hash)
+		return false, err
+	}
+	media.Status = utils.MediaStatusProcessing
+	return true, nil
+}
+
+// discardGalleryUpload undoes the storing of a gallery file that won't be saved as an item:
+// a photo is still waiting in incoming/, a video holds a reference to its blob
+func (s *petService) discardGalleryUpload(media *models.Media) {
+	if media.Type == utils.MediaTypeVideo {
+		s.releaseMediaBlob(media.ContentHash)
+		return
+	}
+	removeIncomingImage(s.store, media.ID)
+}
+
+// toUploadedMediaResponse describes a gallery item returned by an upload
+func toUploadedMediaResponse(store storage.BlobStore, media *models.Media, visibility string) dto.MediaResponse {
+	return dto.MediaResponse{
+		ID:       media.ID,
+		URL:      mediaURL(store, media.Key, visibility),
+		Type:     media.Type,
+		Status:   media.Status,
+		Duration: media.Duration,
+		Width:    media.Width,
+		Height:   media.Height,
+	}
+}
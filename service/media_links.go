@@ -0,0 +1,39 @@
+package service
+
+import (
+	"log"
+	"pet-service/config"
+	"pet-service/storage"
+	"pet-service/utils"
+	"strings"
+)
+
+// mediaURL turns a stored object key into a link a client can load. Objects of public
+// pets may be served from MEDIA_PUBLIC_BASE_URL; everything else gets an expiring
+// presigned link. Absolute URLs left from before keys were stored pass through.
+func mediaURL(store storage.BlobStore, key, visibility string) string {
+	if key == "" || strings.HasPrefix(key, "http://") || strings.HasPrefix(key, "https://") {
+		return key
+	}
+	if visibility == utils.VisibilityPublic && config.AppConfig.MediaPublicBaseURL != "" {
+		return config.AppConfig.MediaPublicBaseURL + "/" + key
+	}
+	url, err := store.PresignGet(key, config.AppConfig.PresignedURLExpiry)
+	if err != nil {
+		log.Printf("Failed to link object %s: %v", key, err)
+		return ""
+	}
+	return url
+}
+
+// mediaVariantURLs resolves each key of a variants map with mediaURL
+func mediaVariantURLs(store storage.BlobStore, variants map[string]string, visibility string) map[string]string {
+	if len(variants) == 0 {
+		return nil
+	}
+	urls := make(map[string]string, len(variants))
+	for name, key := range variants {
+		urls[name] = mediaURL(store, key, visibility)
+	}
+	return urls
+}
@@ -0,0 +1,412 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"pet-service/config"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/storage"
+	"pet-service/utils"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type mediaService struct {
+	mediaRepo repository.IMediaRepository
+	petRepo   repository.IPetRepository
+	feedRepo  repository.IFeedRepository
+	store     storage.BlobStore
+}
+
+// NewMediaService creates a new gallery management service instance
+func NewMediaService(mediaRepo repository.IMediaRepository, petRepo repository.IPetRepository, feedRepo repository.IFeedRepository, store storage.BlobStore) IMediaService {
+	return &mediaService{
+		mediaRepo: mediaRepo,
+		petRepo:   petRepo,
+		feedRepo:  feedRepo,
+		store:     store,
+	}
+}
+
+// getPetMedia loads a gallery item and its pet, requiring at least the given access
+func (s *mediaService) getPetMedia(userInfo middleware.UserInfo, mediaID string, level int) (*models.Media, *models.Pet, error) {
+	media, err := s.mediaRepo.GetMediaByID(mediaID)
+	if err != nil {
+		return nil, nil, errors.New(utils.MediaNotExist)
+	}
+	pet, err := s.petRepo.GetPetByID(media.PetID)
+	if err != nil {
+		return nil, nil, errors.New(utils.MediaNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) < level {
+		return nil, nil, errors.New(utils.PermissionDenied)
+	}
+	return media, pet, nil
+}
+
+// DeleteMedia removes a gallery item and, unless other items share them, its stored files;
+// allowed for the pet's owners and for the caretaker who uploaded it. Items still
+// processing can't be deleted yet.
+func (s *mediaService) DeleteMedia(userInfo middleware.UserInfo, mediaID string) (*dto.MessageResponse, error) {
+	media, pet, err := s.getPetMedia(userInfo, mediaID, petAccessCaretaker)
+	if err != nil {
+		return nil, err
+	}
+	if media.CreatedBy != userInfo.UserID && petAccessLevel(&userInfo, pet, false) != petAccessOwner {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+	// An item processing for longer than BACKGROUND_JOB_TIMEOUT_MINUTES was lost to a
+	// restart; it is failed first, giving back its blob, so it can be deleted
+	if media.Status == utils.MediaStatusProcessing {
+		since := media.CreatedAt
+		if media.UpdatedAt != nil {
+			since = *media.UpdatedAt
+		}
+		if time.Since(since) < config.AppConfig.BackgroundJobTimeout || !failProcessingMedia(s.mediaRepo, s.store, media) {
+			return nil, errors.New(utils.MediaProcessing)
+		}
+	}
+
+	// The blob is removed only with the last item, of any pet, holding the same file
+	unreferenced, err := s.mediaRepo.DeleteMedia(media)
+	if err != nil {
+		return nil, err
+	}
+	if unreferenced {
+		removeMediaBlob(s.mediaRepo, s.store, media.ContentHash)
+	}
+
+	// A photo that failed processing may still be waiting in incoming/, and items stored
+	// before uploads were hashed have objects of their own
+	objects := []string{incomingObjectName(media.ID)}
+	if media.ContentHash == "" {
+		if media.Key != "" {
+			objects = append(objects, media.Key)
+		}
+		for _, key := range media.Variants {
+			objects = append(objects, key)
+		}
+	}
+	for _, object := range objects {
+		if err := s.store.Delete(object); err != nil {
+			log.Printf("Failed to remove %s of deleted media %s: %v", object, media.ID, err)
+		}
+	}
+
+	return &dto.MessageResponse{Message: "Media deleted"}, nil
+}
+
+// UpdateMedia edits a gallery item's caption or album (owners and caretakers)
+func (s *mediaService) UpdateMedia(userInfo middleware.UserInfo, mediaID string, req dto.MediaUpdateRequest) (*dto.MediaItem, error) {
+	media, pet, err := s.getPetMedia(userInfo, mediaID, petAccessCaretaker)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Caption != nil {
+		media.Caption = strings.TrimSpace(*req.Caption)
+	}
+	if req.AlbumID != nil {
+		if *req.AlbumID == "" {
+			media.AlbumID = nil
+		} else {
+			album, err := s.mediaRepo.GetAlbumByID(*req.AlbumID)
+			if err != nil || album.PetID != pet.ID {
+				return nil, errors.New(utils.AlbumNotExist)
+			}
+			media.AlbumID = &album.ID
+		}
+	}
+
+	now := time.Now()
+	media.UpdatedAt = &now
+	media.UpdatedBy = userInfo.UserID
+	if err := s.mediaRepo.UpdateMediaDetails(media); err != nil {
+		return nil, err
+	}
+
+	item := toMediaItem(s.store, media, pet.Visibility)
+	return &item, nil
+}
+
+// ReorderMedias sets the gallery order: the listed items come first, in the order given
+func (s *mediaService) ReorderMedias(userInfo middleware.UserInfo, petID string, req dto.MediaOrderRequest) (*dto.MessageResponse, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) < petAccessCaretaker {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	if err := s.mediaRepo.ReorderMedias(petID, req.MediaIDs); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New(utils.MediaNotExist)
+		}
+		return nil, err
+	}
+
+	return &dto.MessageResponse{Message: "Gallery reordered"}, nil
+}
+
+// SetCover picks the gallery item shown as the pet's cover, or clears it (owners only)
+func (s *mediaService) SetCover(userInfo middleware.UserInfo, petID string, req dto.PetCoverRequest) (*dto.MessageResponse, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) != petAccessOwner {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	if req.MediaID != "" {
+		if _, err := s.getReadyMedia(petID, req.MediaID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.petRepo.UpdatePetCover(petID, req.MediaID); err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{Message: "Cover updated"}, nil
+}
+
+// SetAvatar makes a gallery photo, or a video's poster frame, the pet's avatar (owners
+// only). The files are copied so the avatar outlives the gallery item.
+func (s *mediaService) SetAvatar(userInfo middleware.UserInfo, petID string, req dto.PetAvatarRequest) (*dto.PetResponse, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) != petAccessOwner {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	media, err := s.getReadyMedia(petID, req.MediaID)
+	if err != nil {
+		return nil, err
+	}
+	source := media.Key
+	if media.Type == utils.MediaTypeVideo {
+		source = media.Variants["poster"]
+	}
+	if source == "" {
+		return nil, errors.New(utils.MediaNoImage)
+	}
+
+	key := petObjectName(petID, utils.GenerateUUID())
+	if err := s.store.Copy(source, key); err != nil {
+		return nil, err
+	}
+	variants := make(models.ImageVariants, len(media.Variants))
+	for name, variantKey := range media.Variants {
+		if name == "poster" {
+			continue
+		}
+		variants[name] = imageVariantObjectName(key, name)
+		if err := s.store.Copy(variantKey, variants[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.petRepo.UpdatePetAvatar(petID, key, variants); err != nil {
+		return nil, err
+	}
+
+	pet.AvtKey = key
+	pet.AvtVariants = variants
+	return toPetResponse(s.store, pet, nil), nil
+}
+
+// getReadyMedia loads one of the pet's processed gallery items
+func (s *mediaService) getReadyMedia(petID, mediaID string) (*models.Media, error) {
+	media, err := s.mediaRepo.GetMediaByID(mediaID)
+	if err != nil || media.PetID != petID {
+		return nil, errors.New(utils.MediaNotExist)
+	}
+	if media.Status != utils.MediaStatusReady {
+		return nil, errors.New(utils.MediaProcessing)
+	}
+	return media, nil
+}
+
+// CreateAlbum adds a named album to the pet's gallery (owners and caretakers)
+func (s *mediaService) CreateAlbum(userInfo middleware.UserInfo, petID string, req dto.MediaAlbumRequest) (*dto.MediaAlbumItem, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) < petAccessCaretaker {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	album := &models.MediaAlbum{
+		PetID:       petID,
+		Name:        strings.TrimSpace(req.Name),
+		Description: strings.TrimSpace(req.Description),
+	}
+	album.CreatedBy = userInfo.UserID
+
+	if err := s.mediaRepo.CreateAlbum(album); err != nil {
+		return nil, err
+	}
+
+	item := toMediaAlbumItem(s.store, album, nil, 0, pet.Visibility)
+	return &item, nil
+}
+
+// GetAlbums lists a pet's albums, oldest first, for anyone who can view the pet
+func (s *mediaService) GetAlbums(userInfo middleware.UserInfo, petID string) ([]dto.MediaAlbumItem, error) {
+	pet, err := s.petRepo.GetPetWithOwner(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if resolvePetAccess(s.feedRepo, &userInfo, pet) == petAccessNone {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+
+	albums, err := s.mediaRepo.GetAlbumsByPetID(petID)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := s.mediaRepo.GetAlbumMediaCounts(petID)
+	if err != nil {
+		return nil, err
+	}
+
+	var coverIDs []string
+	for _, album := range albums {
+		if album.CoverMediaID != "" {
+			coverIDs = append(coverIDs, album.CoverMediaID)
+		}
+	}
+	covers, err := s.mediaRepo.GetMediasByIDs(coverIDs)
+	if err != nil {
+		return nil, err
+	}
+	coversByID := make(map[string]*models.Media, len(covers))
+	for i := range covers {
+		coversByID[covers[i].ID] = &covers[i]
+	}
+
+	items := []dto.MediaAlbumItem{}
+	for i := range albums {
+		cover := coversByID[albums[i].CoverMediaID]
+		items = append(items, toMediaAlbumItem(s.store, &albums[i], cover, counts[albums[i].ID], pet.Visibility))
+	}
+	return items, nil
+}
+
+// UpdateAlbum renames an album, edits its description or picks its cover (owners and caretakers)
+func (s *mediaService) UpdateAlbum(userInfo middleware.UserInfo, petID, albumID string, req dto.MediaAlbumUpdateRequest) (*dto.MediaAlbumItem, error) {
+	pet, album, err := s.getPetAlbum(userInfo, petID, albumID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		album.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		album.Description = strings.TrimSpace(*req.Description)
+	}
+	var cover *models.Media
+	if req.CoverMediaID != nil {
+		album.CoverMediaID = *req.CoverMediaID
+	}
+	if album.CoverMediaID != "" {
+		if cover, err = s.getReadyMedia(petID, album.CoverMediaID); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	album.UpdatedAt = &now
+	album.UpdatedBy = userInfo.UserID
+	if err := s.mediaRepo.UpdateAlbum(album); err != nil {
+		return nil, err
+	}
+
+	counts, err := s.mediaRepo.GetAlbumMediaCounts(petID)
+	if err != nil {
+		return nil, err
+	}
+	item := toMediaAlbumItem(s.store, album, cover, counts[album.ID], pet.Visibility)
+	return &item, nil
+}
+
+// DeleteAlbum removes an album; its photos and videos stay in the gallery (owners and caretakers)
+func (s *mediaService) DeleteAlbum(userInfo middleware.UserInfo, petID, albumID string) (*dto.MessageResponse, error) {
+	_, album, err := s.getPetAlbum(userInfo, petID, albumID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	album.IsActive = false
+	album.UpdatedAt = &now
+	album.UpdatedBy = userInfo.UserID
+	if err := s.mediaRepo.DeleteAlbum(album); err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{Message: "Album deleted"}, nil
+}
+
+// getPetAlbum loads one of the pet's albums for an owner or caretaker
+func (s *mediaService) getPetAlbum(userInfo middleware.UserInfo, petID, albumID string) (*models.Pet, *models.MediaAlbum, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) < petAccessCaretaker {
+		return nil, nil, errors.New(utils.PermissionDenied)
+	}
+	album, err := s.mediaRepo.GetAlbumByID(albumID)
+	if err != nil || album.PetID != petID {
+		return nil, nil, errors.New(utils.AlbumNotExist)
+	}
+	return pet, album, nil
+}
+
+// toMediaItem maps a gallery item to its DTO, with links for the pet's visibility
+func toMediaItem(store storage.BlobStore, media *models.Media, visibility string) dto.MediaItem {
+	item := dto.MediaItem{
+		ID:       media.ID,
+		URL:      mediaURL(store, media.Key, visibility),
+		Type:     media.Type,
+		Status:   media.Status,
+		Variants: mediaVariantURLs(store, media.Variants, visibility),
+		Caption:  media.Caption,
+	}
+	if media.AlbumID != nil {
+		item.AlbumID = *media.AlbumID
+	}
+	if media.Type == utils.MediaTypeVideo {
+		item.Duration = media.Duration
+		item.Width, item.Height = media.Width, media.Height
+		item.PlaybackURL = playbackURL(store, media.Key)
+	}
+	return item
+}
+
+func toMediaAlbumItem(store storage.BlobStore, album *models.MediaAlbum, cover *models.Media, count int64, visibility string) dto.MediaAlbumItem {
+	item := dto.MediaAlbumItem{
+		ID:          album.ID,
+		Name:        album.Name,
+		Description: album.Description,
+		MediaCount:  count,
+		CreatedAt:   album.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if cover != nil {
+		coverItem := toMediaItem(store, cover, visibility)
+		item.Cover = &coverItem
+	}
+	return item
+}
@@ -0,0 +1,400 @@
+package service
+
+import (
+	"errors"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/utils"
+	"time"
+)
+
+type medicalService struct {
+	medicalRepo repository.IMedicalRepository
+	petRepo     repository.IPetRepository
+	userRepo    repository.IUserRepository
+}
+
+// NewMedicalService creates a new medical records service instance
+func NewMedicalService(medicalRepo repository.IMedicalRepository, petRepo repository.IPetRepository, userRepo repository.IUserRepository) IMedicalService {
+	return &medicalService{
+		medicalRepo: medicalRepo,
+		petRepo:     petRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// GetMedicalRecords is readable by the pet's owners, caretakers and clinic staff
+func (s *medicalService) GetMedicalRecords(userInfo middleware.UserInfo, petID string) (*dto.MedicalRecordsResponse, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) < petAccessCaretaker && !isStaff(s.userRepo, userInfo) {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	vaccinations, err := s.medicalRepo.GetVaccinationsByPetID(petID)
+	if err != nil {
+		return nil, err
+	}
+	treatments, err := s.medicalRepo.GetTreatmentsByPetID(petID)
+	if err != nil {
+		return nil, err
+	}
+	medications, err := s.medicalRepo.GetMedicationsByPetID(petID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.MedicalRecordsResponse{
+		PetID:        petID,
+		Vaccinations: make([]dto.VaccinationItem, 0, len(vaccinations)),
+		Treatments:   make([]dto.TreatmentItem, 0, len(treatments)),
+		Medications:  make([]dto.MedicationItem, 0, len(medications)),
+	}
+	for i := range vaccinations {
+		response.Vaccinations = append(response.Vaccinations, toVaccinationItem(&vaccinations[i]))
+	}
+	for i := range treatments {
+		response.Treatments = append(response.Treatments, toTreatmentItem(&treatments[i]))
+	}
+	for i := range medications {
+		response.Medications = append(response.Medications, toMedicationItem(&medications[i]))
+	}
+
+	return response, nil
+}
+
+func (s *medicalService) CreateVaccination(userInfo middleware.UserInfo, petID string, req dto.VaccinationRequest) (*dto.VaccinationItem, error) {
+	if err := s.checkRecordRefs(petID, req.AppointmentID); err != nil {
+		return nil, err
+	}
+
+	vaccination := &models.Vaccination{PetID: petID}
+	if err := applyVaccinationRequest(vaccination, req); err != nil {
+		return nil, err
+	}
+	vaccination.CreatedBy = userInfo.UserID
+
+	if err := s.medicalRepo.CreateVaccination(vaccination); err != nil {
+		return nil, err
+	}
+
+	item := toVaccinationItem(vaccination)
+	return &item, nil
+}
+
+func (s *medicalService) UpdateVaccination(userInfo middleware.UserInfo, petID, recordID string, req dto.VaccinationRequest) (*dto.VaccinationItem, error) {
+	if err := s.checkRecordRefs(petID, req.AppointmentID); err != nil {
+		return nil, err
+	}
+
+	vaccination, err := s.medicalRepo.GetVaccinationByID(recordID)
+	if err != nil || vaccination.PetID != petID {
+		return nil, errors.New(utils.MedicalRecordNotExist)
+	}
+	if err := applyVaccinationRequest(vaccination, req); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	vaccination.UpdatedAt = &now
+	vaccination.UpdatedBy = userInfo.UserID
+
+	if err := s.medicalRepo.UpdateVaccination(vaccination); err != nil {
+		return nil, err
+	}
+
+	item := toVaccinationItem(vaccination)
+	return &item, nil
+}
+
+func (s *medicalService) DeleteVaccination(userInfo middleware.UserInfo, petID, recordID string) (*dto.MessageResponse, error) {
+	vaccination, err := s.medicalRepo.GetVaccinationByID(recordID)
+	if err != nil || vaccination.PetID != petID {
+		return nil, errors.New(utils.MedicalRecordNotExist)
+	}
+
+	now := time.Now()
+	vaccination.IsActive = false
+	vaccination.UpdatedAt = &now
+	vaccination.UpdatedBy = userInfo.UserID
+
+	if err := s.medicalRepo.UpdateVaccination(vaccination); err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{Message: "Vaccination deleted"}, nil
+}
+
+func (s *medicalService) CreateTreatment(userInfo middleware.UserInfo, petID string, req dto.TreatmentRequest) (*dto.TreatmentItem, error) {
+	if err := s.checkRecordRefs(petID, req.AppointmentID); err != nil {
+		return nil, err
+	}
+
+	treatment := &models.Treatment{PetID: petID}
+	if err := applyTreatmentRequest(treatment, req); err != nil {
+		return nil, err
+	}
+	treatment.CreatedBy = userInfo.UserID
+
+	if err := s.medicalRepo.CreateTreatment(treatment); err != nil {
+		return nil, err
+	}
+
+	item := toTreatmentItem(treatment)
+	return &item, nil
+}
+
+func (s *medicalService) UpdateTreatment(userInfo middleware.UserInfo, petID, recordID string, req dto.TreatmentRequest) (*dto.TreatmentItem, error) {
+	if err := s.checkRecordRefs(petID, req.AppointmentID); err != nil {
+		return nil, err
+	}
+
+	treatment, err := s.medicalRepo.GetTreatmentByID(recordID)
+	if err != nil || treatment.PetID != petID {
+		return nil, errors.New(utils.MedicalRecordNotExist)
+	}
+	if err := applyTreatmentRequest(treatment, req); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	treatment.UpdatedAt = &now
+	treatment.UpdatedBy = userInfo.UserID
+
+	if err := s.medicalRepo.UpdateTreatment(treatment); err != nil {
+		return nil, err
+	}
+
+	item := toTreatmentItem(treatment)
+	return &item, nil
+}
+
+func (s *medicalService) DeleteTreatment(userInfo middleware.UserInfo, petID, recordID string) (*dto.MessageResponse, error) {
+	treatment, err := s.medicalRepo.GetTreatmentByID(recordID)
+	if err != nil || treatment.PetID != petID {
+		return nil, errors.New(utils.MedicalRecordNotExist)
+	}
+
+	now := time.Now()
+	treatment.IsActive = false
+	treatment.UpdatedAt = &now
+	treatment.UpdatedBy = userInfo.UserID
+
+	if err := s.medicalRepo.UpdateTreatment(treatment); err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{Message: "Treatment deleted"}, nil
+}
+
+func (s *medicalService) CreateMedication(userInfo middleware.UserInfo, petID string, req dto.MedicationRequest) (*dto.MedicationItem, error) {
+	if err := s.checkRecordRefs(petID, req.AppointmentID); err != nil {
+		return nil, err
+	}
+
+	medication := &models.Medication{PetID: petID}
+	if err := applyMedicationRequest(medication, req); err != nil {
+		return nil, err
+	}
+	medication.CreatedBy = userInfo.UserID
+
+	if err := s.medicalRepo.CreateMedication(medication); err != nil {
+		return nil, err
+	}
+
+	item := toMedicationItem(medication)
+	return &item, nil
+}
+
+func (s *medicalService) UpdateMedication(userInfo middleware.UserInfo, petID, recordID string, req dto.MedicationRequest) (*dto.MedicationItem, error) {
+	if err := s.checkRecordRefs(petID, req.AppointmentID); err != nil {
+		return nil, err
+	}
+
+	medication, err := s.medicalRepo.GetMedicationByID(recordID)
+	if err != nil || medication.PetID != petID {
+		return nil, errors.New(utils.MedicalRecordNotExist)
+	}
+	if err := applyMedicationRequest(medication, req); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	medication.UpdatedAt = &now
+	medication.UpdatedBy = userInfo.UserID
+
+	if err := s.medicalRepo.UpdateMedication(medication); err != nil {
+		return nil, err
+	}
+
+	item := toMedicationItem(medication)
+	return &item, nil
+}
+
+func (s *medicalService) DeleteMedication(userInfo middleware.UserInfo, petID, recordID string) (*dto.MessageResponse, error) {
+	medication, err := s.medicalRepo.GetMedicationByID(recordID)
+	if err != nil || medication.PetID != petID {
+		return nil, errors.New(utils.MedicalRecordNotExist)
+	}
+
+	now := time.Now()
+	medication.IsActive = false
+	medication.UpdatedAt = &now
+	medication.UpdatedBy = userInfo.UserID
+
+	if err := s.medicalRepo.UpdateMedication(medication); err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{Message: "Medication deleted"}, nil
+}
+
+// checkRecordRefs verifies the pet and the optional appointment a record points at
+func (s *medicalService) checkRecordRefs(petID, appointmentID string) error {
+	if _, err := s.petRepo.GetPetByID(petID); err != nil {
+		return errors.New(utils.PetIDNotExist)
+	}
+	if appointmentID != "" && !s.medicalRepo.AppointmentExists(appointmentID) {
+		return errors.New(utils.AppointmentNotExist)
+	}
+	return nil
+}
+
+func applyVaccinationRequest(vaccination *models.Vaccination, req dto.VaccinationRequest) error {
+	administeredAt, err := parseRequiredDate(req.AdministeredAt)
+	if err != nil {
+		return err
+	}
+	nextDueAt, err := parseOptionalDate(req.NextDueAt)
+	if err != nil {
+		return err
+	}
+
+	vaccination.Vaccine = req.Vaccine
+	vaccination.BatchNumber = req.BatchNumber
+	vaccination.AdministeredAt = administeredAt
+	vaccination.NextDueAt = nextDueAt
+	vaccination.Veterinarian = req.Veterinarian
+	vaccination.Notes = req.Notes
+	vaccination.AppointmentID = req.AppointmentID
+	return nil
+}
+
+func applyTreatmentRequest(treatment *models.Treatment, req dto.TreatmentRequest) error {
+	treatedAt, err := parseRequiredDate(req.TreatedAt)
+	if err != nil {
+		return err
+	}
+
+	treatment.Diagnosis = req.Diagnosis
+	treatment.Treatment = req.Treatment
+	treatment.TreatedAt = treatedAt
+	treatment.Veterinarian = req.Veterinarian
+	treatment.Notes = req.Notes
+	treatment.AppointmentID = req.AppointmentID
+	return nil
+}
+
+func applyMedicationRequest(medication *models.Medication, req dto.MedicationRequest) error {
+	startDate, err := parseRequiredDate(req.StartDate)
+	if err != nil {
+		return err
+	}
+	endDate, err := parseOptionalDate(req.EndDate)
+	if err != nil {
+		return err
+	}
+	nextDueAt, err := parseOptionalDate(req.NextDueAt)
+	if err != nil {
+		return err
+	}
+
+	medication.Name = req.Name
+	medication.Dosage = req.Dosage
+	medication.Frequency = req.Frequency
+	medication.StartDate = startDate
+	medication.EndDate = endDate
+	medication.NextDueAt = nextDueAt
+	medication.Instructions = req.Instructions
+	medication.PrescribedBy = req.PrescribedBy
+	medication.AppointmentID = req.AppointmentID
+	return nil
+}
+
+func parseRequiredDate(value string) (time.Time, error) {
+	t, _ := utils.ParseDateTime(value)
+	if t == nil {
+		return time.Time{}, errors.New(utils.InvalidDate)
+	}
+	return *t, nil
+}
+
+// parseOptionalDate returns nil for an empty value
+func parseOptionalDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, _ := utils.ParseDateTime(value)
+	if t == nil {
+		return nil, errors.New(utils.InvalidDate)
+	}
+	return t, nil
+}
+
+func formatOptionalDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
+func formatOptionalDateTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
+
+func toVaccinationItem(v *models.Vaccination) dto.VaccinationItem {
+	return dto.VaccinationItem{
+		ID:             v.ID,
+		PetID:          v.PetID,
+		AppointmentID:  v.AppointmentID,
+		Vaccine:        v.Vaccine,
+		BatchNumber:    v.BatchNumber,
+		AdministeredAt: v.AdministeredAt.Format("2006-01-02 15:04:05"),
+		NextDueAt:      formatOptionalDateTime(v.NextDueAt),
+		Veterinarian:   v.Veterinarian,
+		Notes:          v.Notes,
+	}
+}
+
+func toTreatmentItem(t *models.Treatment) dto.TreatmentItem {
+	return dto.TreatmentItem{
+		ID:            t.ID,
+		PetID:         t.PetID,
+		AppointmentID: t.AppointmentID,
+		Diagnosis:     t.Diagnosis,
+		Treatment:     t.Treatment,
+		TreatedAt:     t.TreatedAt.Format("2006-01-02 15:04:05"),
+		Veterinarian:  t.Veterinarian,
+		Notes:         t.Notes,
+	}
+}
+
+func toMedicationItem(m *models.Medication) dto.MedicationItem {
+	return dto.MedicationItem{
+		ID:            m.ID,
+		PetID:         m.PetID,
+		AppointmentID: m.AppointmentID,
+		Name:          m.Name,
+		Dosage:        m.Dosage,
+		Frequency:     m.Frequency,
+		StartDate:     m.StartDate.Format("2006-01-02"),
+		EndDate:       formatOptionalDate(m.EndDate),
+		NextDueAt:     formatOptionalDateTime(m.NextDueAt),
+		Instructions:  m.Instructions,
+		PrescribedBy:  m.PrescribedBy,
+	}
+}
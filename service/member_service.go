@@ -0,0 +1,468 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/storage"
+	"pet-service/utils"
+	"strings"
+	"time"
+)
+
+type memberService struct {
+	memberRepo          repository.IMemberRepository
+	petRepo             repository.IPetRepository
+	userRepo            repository.IUserRepository
+	notificationService INotificationService
+	store               storage.BlobStore
+}
+
+// NewMemberService creates a new co-owner/transfer service instance
+func NewMemberService(memberRepo repository.IMemberRepository, petRepo repository.IPetRepository, userRepo repository.IUserRepository, notificationService INotificationService, store storage.BlobStore) IMemberService {
+	return &memberService{
+		memberRepo:          memberRepo,
+		petRepo:             petRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+		store:               store,
+	}
+}
+
+// Co-owners
+
+func (s *memberService) InviteMember(userInfo middleware.UserInfo, petID string, req dto.PetMemberInviteRequest) (*dto.PetMemberItem, error) {
+	pet, err := s.petRepo.GetPetWithOwner(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) != petAccessOwner {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	email := utils.NormalizeEmail(req.Email)
+	if email == utils.NormalizeEmail(userInfo.Email) {
+		return nil, errors.New(utils.CannotInviteSelf)
+	}
+	if email == utils.NormalizeEmail(pet.User.Email) {
+		return nil, errors.New(utils.PetMemberExists)
+	}
+	if _, err := s.memberRepo.GetOpenMemberByEmail(petID, email); err == nil {
+		return nil, errors.New(utils.PetMemberExists)
+	}
+
+	member := &models.PetMember{
+		PetID:  petID,
+		Email:  email,
+		Role:   req.Role,
+		Status: utils.PetInvitePending,
+	}
+	member.CreatedBy = userInfo.UserID
+
+	// Invites to people without an account wait until they sign up with that email
+	invitee, _ := s.userRepo.GetUserByEmail(email)
+	if invitee == nil {
+		invitee, _ = s.userRepo.GetUserByEmail(req.Email)
+	}
+	if invitee != nil {
+		member.UserID = &invitee.ID
+	}
+
+	if err := s.memberRepo.CreateMember(member); err != nil {
+		return nil, err
+	}
+
+	if invitee != nil {
+		_ = s.notificationService.Notify(invitee.ID, utils.NotificationPetInvite,
+			fmt.Sprintf("%s invited you to help with %s", displayName(userInfo), pet.Name),
+			fmt.Sprintf("You were invited as %s.", req.Role),
+			"/pet-invites")
+	}
+
+	item := toPetMemberItem(member)
+	return &item, nil
+}
+
+func (s *memberService) GetMembers(userInfo middleware.UserInfo, petID string) ([]dto.PetMemberItem, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+
+	access := petAccessLevel(&userInfo, pet, false)
+	if access < petAccessCaretaker {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	members, err := s.memberRepo.GetMembersByPetID(petID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.PetMemberItem, 0, len(members))
+	for i := range members {
+		// Pending invites are only shown to those who can manage them
+		if members[i].Status == utils.PetInvitePending && access != petAccessOwner {
+			continue
+		}
+		items = append(items, toPetMemberItem(&members[i]))
+	}
+
+	return items, nil
+}
+
+func (s *memberService) UpdateMemberRole(userInfo middleware.UserInfo, petID, memberID string, req dto.PetMemberRoleRequest) (*dto.PetMemberItem, error) {
+	member, err := s.getManagedMember(userInfo, petID, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	member.Role = req.Role
+	member.UpdatedAt = &now
+	member.UpdatedBy = userInfo.UserID
+
+	if err := s.memberRepo.UpdateMember(member); err != nil {
+		return nil, err
+	}
+
+	item := toPetMemberItem(member)
+	return &item, nil
+}
+
+// RemoveMember revokes a co-owner or a pending invite; co-owners may also remove themselves
+func (s *memberService) RemoveMember(userInfo middleware.UserInfo, petID, memberID string) (*dto.MessageResponse, error) {
+	member, err := s.memberRepo.GetMemberByID(memberID)
+	if err != nil || member.PetID != petID {
+		return nil, errors.New(utils.PetInviteNotExist)
+	}
+
+	leaving := member.UserID != nil && *member.UserID == userInfo.UserID
+	if !leaving {
+		if member, err = s.getManagedMember(userInfo, petID, memberID); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	member.IsActive = false
+	member.UpdatedAt = &now
+	member.UpdatedBy = userInfo.UserID
+
+	if err := s.memberRepo.UpdateMember(member); err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{Message: "Member removed"}, nil
+}
+
+// getManagedMember loads a membership of the pet that the caller is allowed to manage
+func (s *memberService) getManagedMember(userInfo middleware.UserInfo, petID, memberID string) (*models.PetMember, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) != petAccessOwner {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	member, err := s.memberRepo.GetMemberByID(memberID)
+	if err != nil || member.PetID != petID {
+		return nil, errors.New(utils.PetInviteNotExist)
+	}
+	return member, nil
+}
+
+func (s *memberService) GetInvites(userInfo middleware.UserInfo) ([]dto.PetInviteItem, error) {
+	email, err := s.currentEmail(userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	invites, err := s.memberRepo.GetPendingInvitesByEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.PetInviteItem, 0, len(invites))
+	for _, invite := range invites {
+		items = append(items, dto.PetInviteItem{
+			ID:        invite.ID,
+			PetID:     invite.PetID,
+			PetName:   invite.Pet.Name,
+			PetAvtURL: mediaURL(s.store, invite.Pet.AvtKey, invite.Pet.Visibility),
+			Role:      invite.Role,
+			CreatedAt: invite.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return items, nil
+}
+
+func (s *memberService) AcceptInvite(userInfo middleware.UserInfo, inviteID string) (*dto.PetMemberItem, error) {
+	invite, err := s.getOwnInvite(userInfo, inviteID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	invite.UserID = &userInfo.UserID
+	invite.Status = utils.PetInviteAccepted
+	invite.RespondedAt = &now
+	invite.UpdatedAt = &now
+	invite.UpdatedBy = userInfo.UserID
+
+	if err := s.memberRepo.UpdateMember(invite); err != nil {
+		return nil, err
+	}
+
+	item := toPetMemberItem(invite)
+	return &item, nil
+}
+
+func (s *memberService) DeclineInvite(userInfo middleware.UserInfo, inviteID string) (*dto.MessageResponse, error) {
+	invite, err := s.getOwnInvite(userInfo, inviteID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	invite.Status = utils.PetInviteDeclined
+	invite.RespondedAt = &now
+	invite.UpdatedAt = &now
+	invite.UpdatedBy = userInfo.UserID
+
+	if err := s.memberRepo.UpdateMember(invite); err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{Message: "Invite declined"}, nil
+}
+
+// getOwnInvite loads a pending invite addressed to the caller's email
+func (s *memberService) getOwnInvite(userInfo middleware.UserInfo, inviteID string) (*models.PetMember, error) {
+	email, err := s.currentEmail(userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	invite, err := s.memberRepo.GetMemberByID(inviteID)
+	if err != nil || invite.Email != email || invite.Status != utils.PetInvitePending {
+		return nil, errors.New(utils.PetInviteNotExist)
+	}
+	if _, err := s.petRepo.GetPetByID(invite.PetID); err != nil {
+		return nil, errors.New(utils.PetInviteNotExist)
+	}
+	return invite, nil
+}
+
+// Ownership transfers
+
+func (s *memberService) TransferOwnership(userInfo middleware.UserInfo, petID string, req dto.PetTransferRequest) (*dto.PetTransferItem, error) {
+	pet, err := s.petRepo.GetPetWithOwner(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	// Co-owners can manage the pet, but only the primary owner can give it away
+	if pet.UserID != userInfo.UserID && !userInfo.IsAdmin {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	email := utils.NormalizeEmail(req.Email)
+	if email == utils.NormalizeEmail(pet.User.Email) {
+		return nil, errors.New(utils.CannotInviteSelf)
+	}
+	if _, err := s.memberRepo.GetPendingTransferByPetID(petID); err == nil {
+		return nil, errors.New(utils.PetTransferPending)
+	}
+
+	transfer := &models.PetTransfer{
+		PetID:             petID,
+		FromUserID:        pet.UserID,
+		ToEmail:           email,
+		Status:            utils.PetInvitePending,
+		PreviousOwnerRole: req.PreviousOwnerRole,
+	}
+	transfer.CreatedBy = userInfo.UserID
+
+	recipient, _ := s.userRepo.GetUserByEmail(email)
+	if recipient == nil {
+		recipient, _ = s.userRepo.GetUserByEmail(req.Email)
+	}
+	if recipient != nil {
+		transfer.ToUserID = &recipient.ID
+	}
+
+	if err := s.memberRepo.CreateTransfer(transfer); err != nil {
+		return nil, err
+	}
+
+	if recipient != nil {
+		_ = s.notificationService.Notify(recipient.ID, utils.NotificationPetTransfer,
+			fmt.Sprintf("%s wants to transfer %s to you", displayName(userInfo), pet.Name),
+			"Accept to become the owner, with all of the pet's history.",
+			"/pet-transfers")
+	}
+
+	item := toPetTransferItem(transfer)
+	item.PetName = pet.Name
+	item.PetAvtURL = mediaURL(s.store, pet.AvtKey, pet.Visibility)
+	return &item, nil
+}
+
+func (s *memberService) CancelTransfer(userInfo middleware.UserInfo, petID string) (*dto.MessageResponse, error) {
+	transfer, err := s.memberRepo.GetPendingTransferByPetID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetTransferNotExist)
+	}
+	if transfer.FromUserID != userInfo.UserID && !userInfo.IsAdmin {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	now := time.Now()
+	transfer.Status = utils.PetInviteCancelled
+	transfer.RespondedAt = &now
+	transfer.UpdatedAt = &now
+	transfer.UpdatedBy = userInfo.UserID
+
+	if err := s.memberRepo.UpdateTransfer(transfer); err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{Message: "Transfer cancelled"}, nil
+}
+
+func (s *memberService) GetTransfers(userInfo middleware.UserInfo) ([]dto.PetTransferItem, error) {
+	email, err := s.currentEmail(userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers, err := s.memberRepo.GetPendingTransfersByEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.PetTransferItem, 0, len(transfers))
+	for i := range transfers {
+		item := toPetTransferItem(&transfers[i])
+		item.PetName = transfers[i].Pet.Name
+		item.PetAvtURL = mediaURL(s.store, transfers[i].Pet.AvtKey, transfers[i].Pet.Visibility)
+		item.FromName = strings.TrimSpace(transfers[i].FromUser.FirstName + " " + transfers[i].FromUser.LastName)
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (s *memberService) AcceptTransfer(userInfo middleware.UserInfo, transferID string) (*dto.PetTransferItem, error) {
+	transfer, pet, err := s.getOwnTransfer(userInfo, transferID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.memberRepo.AcceptTransfer(transfer, userInfo.UserID); err != nil {
+		return nil, err
+	}
+
+	_ = s.notificationService.Notify(transfer.FromUserID, utils.NotificationPetTransfer,
+		fmt.Sprintf("%s accepted %s", displayName(userInfo), pet.Name),
+		"The ownership transfer is complete.",
+		"/pet/"+pet.ID)
+
+	item := toPetTransferItem(transfer)
+	item.PetName = pet.Name
+	item.PetAvtURL = mediaURL(s.store, pet.AvtKey, pet.Visibility)
+	return &item, nil
+}
+
+func (s *memberService) DeclineTransfer(userInfo middleware.UserInfo, transferID string) (*dto.MessageResponse, error) {
+	transfer, _, err := s.getOwnTransfer(userInfo, transferID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	transfer.Status = utils.PetInviteDeclined
+	transfer.ToUserID = &userInfo.UserID
+	transfer.RespondedAt = &now
+	transfer.UpdatedAt = &now
+	transfer.UpdatedBy = userInfo.UserID
+
+	if err := s.memberRepo.UpdateTransfer(transfer); err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{Message: "Transfer declined"}, nil
+}
+
+// getOwnTransfer loads a pending transfer addressed to the caller. A transfer
+// whose pet changed hands in the meantime is treated as gone.
+func (s *memberService) getOwnTransfer(userInfo middleware.UserInfo, transferID string) (*models.PetTransfer, *models.Pet, error) {
+	email, err := s.currentEmail(userInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transfer, err := s.memberRepo.GetTransferByID(transferID)
+	if err != nil || transfer.ToEmail != email || transfer.Status != utils.PetInvitePending {
+		return nil, nil, errors.New(utils.PetTransferNotExist)
+	}
+
+	pet, err := s.petRepo.GetPetByID(transfer.PetID)
+	if err != nil || pet.UserID != transfer.FromUserID {
+		return nil, nil, errors.New(utils.PetTransferNotExist)
+	}
+	return transfer, pet, nil
+}
+
+// currentEmail reads the caller's email from the database rather than the token,
+// so invites follow email changes
+func (s *memberService) currentEmail(userInfo middleware.UserInfo) (string, error) {
+	user, err := s.userRepo.GetUserByID(userInfo.UserID)
+	if err != nil {
+		return "", errors.New(utils.UserIsNotExist)
+	}
+	return utils.NormalizeEmail(user.Email), nil
+}
+
+func displayName(userInfo middleware.UserInfo) string {
+	if name := strings.TrimSpace(userInfo.FirstName + " " + userInfo.LastName); name != "" {
+		return name
+	}
+	return userInfo.Username
+}
+
+func toPetMemberItem(member *models.PetMember) dto.PetMemberItem {
+	item := dto.PetMemberItem{
+		ID:        member.ID,
+		Email:     member.Email,
+		Role:      member.Role,
+		Status:    member.Status,
+		CreatedAt: member.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if member.UserID != nil {
+		item.UserID = *member.UserID
+	}
+	if member.User != nil {
+		item.FirstName = member.User.FirstName
+		item.LastName = member.User.LastName
+		item.AvatarURL = member.User.AvatarURL
+	}
+	return item
+}
+
+func toPetTransferItem(transfer *models.PetTransfer) dto.PetTransferItem {
+	return dto.PetTransferItem{
+		ID:                transfer.ID,
+		PetID:             transfer.PetID,
+		FromUserID:        transfer.FromUserID,
+		ToEmail:           transfer.ToEmail,
+		Status:            transfer.Status,
+		PreviousOwnerRole: transfer.PreviousOwnerRole,
+		CreatedAt:         transfer.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
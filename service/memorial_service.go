@@ -0,0 +1,201 @@
+package service
+
+import (
+	"archive/zip"
+	"errors"
+	"io"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/storage"
+	"pet-service/utils"
+	"strings"
+	"time"
+)
+
+type memorialService struct {
+	petRepo  repository.IPetRepository
+	feedRepo repository.IFeedRepository
+	store    storage.BlobStore
+}
+
+// NewMemorialService creates a new memorial service instance
+func NewMemorialService(petRepo repository.IPetRepository, feedRepo repository.IFeedRepository, store storage.BlobStore) IMemorialService {
+	return &memorialService{
+		petRepo:  petRepo,
+		feedRepo: feedRepo,
+		store:    store,
+	}
+}
+
+// CreateTribute leaves a message on a memorial profile; anyone who can see the pet may post
+func (s *memorialService) CreateTribute(userInfo middleware.UserInfo, petID string, req dto.TributeRequest) (*dto.TributeItem, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil || resolvePetAccess(s.feedRepo, &userInfo, pet) == petAccessNone {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if !isMemorial(pet) {
+		return nil, errors.New(utils.PetNotMemorial)
+	}
+
+	tribute := &models.PetTribute{
+		PetID:   petID,
+		UserID:  userInfo.UserID,
+		Message: strings.TrimSpace(req.Message),
+	}
+	tribute.CreatedBy = userInfo.UserID
+
+	if err := s.petRepo.CreateTribute(tribute); err != nil {
+		return nil, err
+	}
+
+	return &dto.TributeItem{
+		ID:        tribute.ID,
+		UserID:    userInfo.UserID,
+		FirstName: userInfo.FirstName,
+		LastName:  userInfo.LastName,
+		Message:   tribute.Message,
+		CreatedAt: tribute.CreatedAt.Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+func (s *memorialService) GetTributes(userInfo middleware.UserInfo, petID string) ([]dto.TributeItem, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil || resolvePetAccess(s.feedRepo, &userInfo, pet) == petAccessNone {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+
+	tributes, err := s.petRepo.GetTributesByPetID(petID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.TributeItem, 0, len(tributes))
+	for i := range tributes {
+		items = append(items, toTributeItem(&tributes[i]))
+	}
+
+	return items, nil
+}
+
+// DeleteTribute removes a tribute; allowed for its author and the pet's owners
+func (s *memorialService) DeleteTribute(userInfo middleware.UserInfo, petID, tributeID string) (*dto.MessageResponse, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+
+	tribute, err := s.petRepo.GetTributeByID(tributeID)
+	if err != nil || tribute.PetID != petID {
+		return nil, errors.New(utils.TributeNotExist)
+	}
+	if tribute.UserID != userInfo.UserID && petAccessLevel(&userInfo, pet, false) != petAccessOwner {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	now := time.Now()
+	tribute.IsActive = false
+	tribute.UpdatedAt = &now
+	tribute.UpdatedBy = userInfo.UserID
+
+	if err := s.petRepo.UpdateTribute(tribute); err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{Message: "Tribute deleted"}, nil
+}
+
+// GetArchive prepares a ZIP of the pet's profile, life events, tributes and photos (owners only).
+// Photos are fetched from storage one at a time while the archive is written.
+func (s *memorialService) GetArchive(userInfo middleware.UserInfo, petID string) (*PetArchive, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) != petAccessOwner {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	events, err := s.petRepo.GetLifeEventsByPetID(petID)
+	if err != nil {
+		return nil, err
+	}
+	medias, err := s.petRepo.GetMediasByPetID(petID)
+	if err != nil {
+		return nil, err
+	}
+	tributes, err := s.petRepo.GetTributesByPetID(petID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PetArchive{
+		FileName: archiveFileName(pet.Name) + ".zip",
+		Write: func(w io.Writer) error {
+			return writeMemorialArchive(s.store, w, pet, events, medias, tributes)
+		},
+	}, nil
+}
+
+func writeMemorialArchive(store storage.BlobStore, w io.Writer, pet *models.Pet, events []models.PetLifeEvent, medias []models.Media, tributes []models.PetTribute) error {
+	archive := zip.NewWriter(w)
+
+	profile := map[string]interface{}{
+		"id":            pet.ID,
+		"name":          pet.Name,
+		"type":          pet.Type,
+		"breed":         pet.Breed,
+		"gender":        pet.Gender,
+		"description":   pet.Description,
+		"date_of_birth": formatOptionalDate(pet.DateOfBirth),
+		"date_of_death": formatOptionalDate(pet.DateOfDeath),
+	}
+	if err := writeArchiveJSON(archive, "pet.json", profile); err != nil {
+		return err
+	}
+
+	eventItems := make([]dto.PetLifeEventItem, 0, len(events))
+	for i := range events {
+		eventItems = append(eventItems, toPetLifeEventItem(&events[i]))
+	}
+	if err := writeArchiveJSON(archive, "life-events.json", eventItems); err != nil {
+		return err
+	}
+
+	tributeItems := make([]dto.TributeItem, 0, len(tributes))
+	for i := range tributes {
+		tributeItems = append(tributeItems, toTributeItem(&tributes[i]))
+	}
+	if err := writeArchiveJSON(archive, "tributes.json", tributeItems); err != nil {
+		return err
+	}
+
+	if _, err := writeArchivePhotos(store, archive, "photos", archivePhotos(pet, medias)); err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
+
+func toTributeItem(tribute *models.PetTribute) dto.TributeItem {
+	return dto.TributeItem{
+		ID:        tribute.ID,
+		UserID:    tribute.UserID,
+		FirstName: tribute.User.FirstName,
+		LastName:  tribute.User.LastName,
+		AvatarURL: tribute.User.AvatarURL,
+		Message:   tribute.Message,
+		CreatedAt: tribute.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func toPetLifeEventItem(event *models.PetLifeEvent) dto.PetLifeEventItem {
+	return dto.PetLifeEventItem{
+		ID:       event.ID,
+		Title:    event.Title,
+		Date:     event.Date.Format("2006-01-02"),
+		Location: event.Location,
+		Story:    event.Story,
+	}
+}
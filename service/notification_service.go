@@ -0,0 +1,87 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/utils"
+	"time"
+)
+
+type notificationService struct {
+	notificationRepo repository.INotificationRepository
+}
+
+// NewNotificationService creates a new notification service instance
+func NewNotificationService(notificationRepo repository.INotificationRepository) INotificationService {
+	return &notificationService{
+		notificationRepo: notificationRepo,
+	}
+}
+
+// Notify stores an in-app notification for the user; there is no push or email delivery
+func (s *notificationService) Notify(userID, notificationType, title, body, link string) error {
+	notification := &models.Notification{
+		UserID: userID,
+		Type:   notificationType,
+		Title:  utils.TruncateString(title, 255),
+		Body:   body,
+		Link:   utils.TruncateString(link, 255),
+	}
+
+	if err := s.notificationRepo.CreateNotification(notification); err != nil {
+		log.Printf("Failed to store %s notification for user %s: %v", notificationType, userID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *notificationService) GetNotifications(userInfo middleware.UserInfo, unreadOnly bool) ([]dto.NotificationItem, error) {
+	notifications, err := s.notificationRepo.GetNotificationsByUserID(userInfo.UserID, unreadOnly, utils.NotificationListLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []dto.NotificationItem{}
+	for _, n := range notifications {
+		items = append(items, toNotificationItem(&n))
+	}
+
+	return items, nil
+}
+
+func (s *notificationService) MarkRead(userInfo middleware.UserInfo, notificationID string) (*dto.NotificationItem, error) {
+	notification, err := s.notificationRepo.GetNotificationByID(notificationID)
+	if err != nil || notification.UserID != userInfo.UserID {
+		return nil, errors.New(utils.NotificationNotExist)
+	}
+
+	if notification.ReadAt == nil {
+		now := time.Now()
+		notification.ReadAt = &now
+		notification.UpdatedAt = &now
+		notification.UpdatedBy = userInfo.UserID
+		if err := s.notificationRepo.UpdateNotification(notification); err != nil {
+			return nil, err
+		}
+	}
+
+	item := toNotificationItem(notification)
+	return &item, nil
+}
+
+func toNotificationItem(n *models.Notification) dto.NotificationItem {
+	return dto.NotificationItem{
+		ID:        n.ID,
+		Type:      n.Type,
+		Title:     n.Title,
+		Body:      n.Body,
+		Link:      n.Link,
+		IsRead:    n.ReadAt != nil,
+		CreatedAt: n.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
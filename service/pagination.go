@@ -0,0 +1,69 @@
+package service
+
+import (
+	"math"
+	"pet-service/dto"
+	"pet-service/repository"
+	"pet-service/utils"
+	"time"
+)
+
+// pageRequest converts a validated PageQuery into a repository page request.
+// Offset pages always count the total, as they did before cursors existed.
+func pageRequest(query dto.PageQuery) (repository.PageRequest, error) {
+	page := repository.PageRequest{
+		Page: query.Page,
+		Size: query.PageSize,
+	}
+
+	if query.Cursor == "" && query.Pagination != utils.PaginationCursor {
+		page.CountTotal = true
+		return page, nil
+	}
+
+	page.Keyset = true
+	page.CountTotal = query.IncludeTotal
+	if query.Cursor != "" {
+		cursor, err := utils.DecodePageCursor(query.Cursor)
+		if err != nil {
+			return page, err
+		}
+		page.Cursor = cursor
+	}
+	return page, nil
+}
+
+// pageMeta describes a page of rows; key returns a row's created_at and ID
+func pageMeta[T any](page repository.PageRequest, info repository.PageInfo, rows []T, key func(*T) (time.Time, string)) dto.PaginationMeta {
+	meta := dto.PaginationMeta{
+		PageSize:   page.Size,
+		TotalItems: info.Total,
+	}
+
+	if !page.Keyset {
+		meta.Page = page.Page
+		if info.Total != nil {
+			totalPages := int64(math.Ceil(float64(*info.Total) / float64(page.Size)))
+			meta.TotalPages = &totalPages
+		}
+		return meta
+	}
+
+	if len(rows) == 0 {
+		return meta
+	}
+
+	// Older rows exist past a full forward page, and always when we paged back from them;
+	// newer rows exist when we arrived by cursor, or past a full backward page
+	backward := page.Cursor != nil && page.Cursor.Backward
+	if info.HasMore || backward {
+		createdAt, id := key(&rows[len(rows)-1])
+		meta.NextCursor = utils.EncodePageCursor(utils.PageCursor{CreatedAt: createdAt, ID: id})
+	}
+	if (backward && info.HasMore) || (!backward && page.Cursor != nil) {
+		createdAt, id := key(&rows[0])
+		meta.PrevCursor = utils.EncodePageCursor(utils.PageCursor{CreatedAt: createdAt, ID: id, Backward: true})
+	}
+
+	return meta
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"errors"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/storage"
+	"pet-service/utils"
+	"strings"
+	"time"
+)
+
+type pedigreeService struct {
+	petRepo  repository.IPetRepository
+	feedRepo repository.IFeedRepository
+	store    storage.BlobStore
+}
+
+// NewPedigreeService creates a new pedigree service instance
+func NewPedigreeService(petRepo repository.IPetRepository, feedRepo repository.IFeedRepository, store storage.BlobStore) IPedigreeService {
+	return &pedigreeService{
+		petRepo:  petRepo,
+		feedRepo: feedRepo,
+		store:    store,
+	}
+}
+
+func (s *pedigreeService) SetParents(userInfo middleware.UserInfo, petID string, req dto.PetParentsRequest) (*dto.PedigreeNode, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+
+	if resolvePetAccess(s.feedRepo, &userInfo, pet) != petAccessOwner {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	pet.SireID, pet.SireName, err = s.assignParent(&userInfo, pet, pet.SireID, pet.SireName, req.SireID, req.SireName, true)
+	if err != nil {
+		return nil, err
+	}
+	pet.DamID, pet.DamName, err = s.assignParent(&userInfo, pet, pet.DamID, pet.DamName, req.DamID, req.DamName, false)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	pet.UpdatedAt = &now
+	pet.UpdatedBy = userInfo.UserID
+
+	if err := s.petRepo.UpdatePet(pet); err != nil {
+		return nil, err
+	}
+
+	return s.buildPedigree(&userInfo, pet, 1)
+}
+
+// assignParent applies one side of a PetParentsRequest. Linking a pet clears
+// the external name and setting a name clears the link.
+func (s *pedigreeService) assignParent(userInfo *middleware.UserInfo, pet *models.Pet, currentID *string, currentName string, id, name *string, male bool) (*string, string, error) {
+	if id != nil && *id != "" {
+		if err := s.validateParent(userInfo, pet, *id, male); err != nil {
+			return nil, "", err
+		}
+		parentID := *id
+		return &parentID, "", nil
+	}
+
+	if name != nil {
+		return nil, strings.TrimSpace(*name), nil
+	}
+	if id != nil {
+		return nil, "", nil
+	}
+
+	return currentID, currentName, nil
+}
+
+func (s *pedigreeService) validateParent(userInfo *middleware.UserInfo, pet *models.Pet, parentID string, male bool) error {
+	if parentID == pet.ID {
+		return errors.New(utils.PedigreeCycle)
+	}
+
+	// Parents may belong to other users, but only pets the caller can see are linkable
+	parent, err := s.petRepo.GetPetByID(parentID)
+	if err != nil || resolvePetAccess(s.feedRepo, userInfo, parent) == petAccessNone {
+		return errors.New(utils.InvalidParent)
+	}
+
+	if parent.Gender != male {
+		return errors.New(utils.ParentGenderMismatch)
+	}
+
+	if pet.SpeciesCode != "" && parent.SpeciesCode != "" &&
+		pet.SpeciesCode != utils.CatalogOther && parent.SpeciesCode != utils.CatalogOther &&
+		pet.SpeciesCode != parent.SpeciesCode {
+		return errors.New(utils.ParentSpeciesMismatch)
+	}
+
+	ancestors, err := s.petRepo.GetAncestorIDs(parentID, utils.PedigreeCycleCheckDepth)
+	if err != nil {
+		return err
+	}
+	for _, id := range ancestors {
+		if id == pet.ID {
+			return errors.New(utils.PedigreeCycle)
+		}
+	}
+
+	return nil
+}
+
+func (s *pedigreeService) GetPedigree(userInfo middleware.UserInfo, petID string, depth int) (*dto.PedigreeNode, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil || resolvePetAccess(s.feedRepo, &userInfo, pet) == petAccessNone {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+
+	if depth <= 0 {
+		depth = utils.PedigreeDefaultDepth
+	}
+	if depth > utils.PedigreeMaxDepth {
+		depth = utils.PedigreeMaxDepth
+	}
+
+	return s.buildPedigree(&userInfo, pet, depth)
+}
+
+// buildPedigree loads the ancestry one generation per query, then assembles the tree
+func (s *pedigreeService) buildPedigree(viewer *middleware.UserInfo, pet *models.Pet, depth int) (*dto.PedigreeNode, error) {
+	pets := map[string]*models.Pet{pet.ID: pet}
+	generation := []*models.Pet{pet}
+
+	for level := 0; level < depth && len(generation) > 0; level++ {
+		var parentIDs, missing []string
+		for _, p := range generation {
+			for _, id := range []*string{p.SireID, p.DamID} {
+				if id == nil {
+					continue
+				}
+				parentIDs = append(parentIDs, *id)
+				if _, ok := pets[*id]; !ok {
+					missing = append(missing, *id)
+				}
+			}
+		}
+
+		loaded, err := s.petRepo.GetPetsByIDs(missing)
+		if err != nil {
+			return nil, err
+		}
+		for i := range loaded {
+			pets[loaded[i].ID] = &loaded[i]
+		}
+
+		generation = generation[:0:0]
+		for _, id := range parentIDs {
+			if p, ok := pets[id]; ok {
+				generation = append(generation, p)
+			}
+		}
+	}
+
+	access := make(map[string]int)
+	var build func(p *models.Pet, level int) *dto.PedigreeNode
+	build = func(p *models.Pet, level int) *dto.PedigreeNode {
+		if _, ok := access[p.ID]; !ok {
+			access[p.ID] = resolvePetAccess(s.feedRepo, viewer, p)
+		}
+		// Ancestors the viewer can't see keep their place in the tree but nothing else
+		if level > 0 && access[p.ID] == petAccessNone {
+			return &dto.PedigreeNode{Gender: p.Gender, Hidden: true}
+		}
+
+		node := &dto.PedigreeNode{
+			ID:          p.ID,
+			Name:        p.Name,
+			Gender:      p.Gender,
+			Type:        p.Type,
+			Breed:       p.Breed,
+			AvtURL:      mediaURL(s.store, p.AvtKey, p.Visibility),
+			DateOfBirth: formatOptionalDate(p.DateOfBirth),
+		}
+		if level >= depth {
+			return node
+		}
+
+		node.Sire = parentNode(p.SireID, p.SireName, true, pets, func(parent *models.Pet) *dto.PedigreeNode {
+			return build(parent, level+1)
+		})
+		node.Dam = parentNode(p.DamID, p.DamName, false, pets, func(parent *models.Pet) *dto.PedigreeNode {
+			return build(parent, level+1)
+		})
+		return node
+	}
+
+	return build(pet, 0), nil
+}
+
+// parentNode resolves one parent slot: a linked pet, an external name-only record, or nothing
+func parentNode(id *string, name string, male bool, pets map[string]*models.Pet, build func(*models.Pet) *dto.PedigreeNode) *dto.PedigreeNode {
+	if id != nil {
+		if parent, ok := pets[*id]; ok {
+			return build(parent)
+		}
+		// Linked parent was removed
+		return nil
+	}
+	if name != "" {
+		return &dto.PedigreeNode{Name: name, Gender: male, External: true}
+	}
+	return nil
+}
+
+func (s *pedigreeService) GetSiblings(userInfo middleware.UserInfo, petID string) ([]dto.PetRelativeItem, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil || resolvePetAccess(s.feedRepo, &userInfo, pet) == petAccessNone {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+
+	siblings, err := s.petRepo.GetSiblings(pet)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.PetRelativeItem, 0, len(siblings))
+	for i := range siblings {
+		sibling := &siblings[i]
+		if resolvePetAccess(s.feedRepo, &userInfo, sibling) == petAccessNone {
+			continue
+		}
+		relation := utils.SiblingHalf
+		if sameParent(pet.SireID, sibling.SireID) && sameParent(pet.DamID, sibling.DamID) {
+			relation = utils.SiblingFull
+		}
+		items = append(items, toPetRelativeItem(s.store, sibling, relation))
+	}
+
+	return items, nil
+}
+
+func (s *pedigreeService) GetOffspring(userInfo middleware.UserInfo, petID string) ([]dto.PetRelativeItem, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil || resolvePetAccess(s.feedRepo, &userInfo, pet) == petAccessNone {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+
+	offspring, err := s.petRepo.GetOffspring(pet.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.PetRelativeItem, 0, len(offspring))
+	for i := range offspring {
+		if resolvePetAccess(s.feedRepo, &userInfo, &offspring[i]) == petAccessNone {
+			continue
+		}
+		items = append(items, toPetRelativeItem(s.store, &offspring[i], ""))
+	}
+
+	return items, nil
+}
+
+func sameParent(a, b *string) bool {
+	return a != nil && b != nil && *a == *b
+}
+
+func toPetRelativeItem(store storage.BlobStore, pet *models.Pet, relation string) dto.PetRelativeItem {
+	return dto.PetRelativeItem{
+		ID:          pet.ID,
+		Name:        pet.Name,
+		Gender:      pet.Gender,
+		Type:        pet.Type,
+		Breed:       pet.Breed,
+		AvtURL:      mediaURL(store, pet.AvtKey, pet.Visibility),
+		DateOfBirth: formatOptionalDate(pet.DateOfBirth),
+		Relation:    relation,
+	}
+}
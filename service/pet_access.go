@@ -0,0 +1,139 @@
+package service
+
+import (
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/utils"
+	"slices"
+)
+
+// Pet access levels, ordered from least to most privileged.
+// Caretakers look after the pet day to day (life events, photos, health
+// records) but cannot change its profile, sharing or co-owners.
+const (
+	petAccessNone = iota
+	petAccessView
+	petAccessCaretaker
+	petAccessOwner
+)
+
+// resolvePetAccess returns what the viewer may do with a pet.
+// A nil viewer means an anonymous request (e.g. a public share link).
+func resolvePetAccess(feedRepo repository.IFeedRepository, viewer *middleware.UserInfo, pet *models.Pet) int {
+	following := false
+	if viewer != nil && pet.Visibility == utils.VisibilityFollowers && viewer.UserID != pet.UserID && memberRole(pet, viewer.UserID) == "" {
+		following = feedRepo.IsFollowing(viewer.UserID, pet.ID)
+	}
+	return petAccessLevel(viewer, pet, following)
+}
+
+// petAccessLevel is resolvePetAccess for callers that already know the follow state
+func petAccessLevel(viewer *middleware.UserInfo, pet *models.Pet, following bool) int {
+	if viewer != nil && (viewer.IsAdmin || viewer.UserID == pet.UserID) {
+		return petAccessOwner
+	}
+
+	if viewer != nil {
+		switch memberRole(pet, viewer.UserID) {
+		case utils.PetRoleOwner:
+			return petAccessOwner
+		case utils.PetRoleCaretaker:
+			return petAccessCaretaker
+		case utils.PetRoleViewer:
+			return petAccessView
+		}
+	}
+
+	switch pet.Visibility {
+	case utils.VisibilityPublic, "":
+		return petAccessView
+	case utils.VisibilityFollowers:
+		if following {
+			return petAccessView
+		}
+	}
+
+	return petAccessNone
+}
+
+// isMemorial reports whether the pet has passed away; its profile then becomes a memorial
+func isMemorial(pet *models.Pet) bool {
+	return pet.DateOfDeath != nil
+}
+
+// memberRole returns the user's co-owner role on a pet, or "" when they are not a co-owner.
+// Only accepted memberships count; the repository preloads them with the pet.
+func memberRole(pet *models.Pet, userID string) string {
+	for _, m := range pet.Members {
+		if m.UserID != nil && *m.UserID == userID && m.Status == utils.PetInviteAccepted && m.IsActive {
+			return m.Role
+		}
+	}
+	return ""
+}
+
+// isStaff reports whether the user works for the clinic (can view any pet)
+func isStaff(userRepo repository.IUserRepository, userInfo middleware.UserInfo) bool {
+	return hasStaffPermission(userRepo, userInfo, utils.PermissionViewPet, utils.PermissionEditPet)
+}
+
+// isStaffEditor reports whether the user may write any pet's health records: admins and
+// holders of edit_pet, not staff who can only view
+func isStaffEditor(userRepo repository.IUserRepository, userInfo middleware.UserInfo) bool {
+	return hasStaffPermission(userRepo, userInfo, utils.PermissionEditPet)
+}
+
+func hasStaffPermission(userRepo repository.IUserRepository, userInfo middleware.UserInfo, accepted ...string) bool {
+	if userInfo.IsAdmin {
+		return true
+	}
+	permissions, err := userRepo.GetPermissionsByUserID(userInfo.UserID)
+	if err != nil {
+		return false
+	}
+	for _, p := range permissions {
+		if slices.Contains(accepted, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldVisible reports whether an owner profile field with the given
+// visibility can be shown to a viewer of one of the owner's pets
+func fieldVisible(visibility string, access int, following bool) bool {
+	if access == petAccessOwner {
+		return true
+	}
+	switch visibility {
+	case utils.VisibilityPublic:
+		return true
+	case utils.VisibilityFollowers:
+		return following
+	}
+	return false
+}
+
+// toPetOwnerResponse sanitizes the owner record according to their privacy settings
+func toPetOwnerResponse(owner *models.User, access int, following bool) *dto.PetOwnerResponse {
+	if owner == nil || owner.ID == "" {
+		return nil
+	}
+
+	response := &dto.PetOwnerResponse{
+		ID:        owner.ID,
+		FirstName: owner.FirstName,
+		LastName:  owner.LastName,
+		AvatarURL: owner.AvatarURL,
+	}
+	if fieldVisible(owner.EmailVisibility, access, following) {
+		response.Email = owner.Email
+	}
+	if fieldVisible(owner.PhoneVisibility, access, following) {
+		response.Phone = owner.Phone
+	}
+
+	return response
+}
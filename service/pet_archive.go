@@ -0,0 +1,106 @@
+package service
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"pet-service/models"
+	"pet-service/storage"
+	"strings"
+)
+
+// archivePhoto is a stored object to copy into an archive under a readable name
+type archivePhoto struct {
+	object string
+	name   string
+}
+
+// archivePhotos lists the pet's avatar first, then the gallery in its display order
+func archivePhotos(pet *models.Pet, medias []models.Media) []archivePhoto {
+	photos := make([]archivePhoto, 0, len(medias)+1)
+	if strings.HasPrefix(pet.AvtKey, petObjectName(pet.ID, "")) {
+		photos = append(photos, archivePhoto{pet.AvtKey, "avatar"})
+	}
+	for _, m := range medias {
+		photos = append(photos, archivePhoto{m.Key, m.Name})
+	}
+	return photos
+}
+
+// writeArchivePhotos streams each photo from storage into dir/NNN-name and returns
+// the entry names written. Objects missing from storage are logged and skipped.
+func writeArchivePhotos(store storage.BlobStore, archive *zip.Writer, dir string, photos []archivePhoto) ([]string, error) {
+	entries := make([]string, 0, len(photos))
+	for i, p := range photos {
+		name := fmt.Sprintf("%s/%03d-%s", dir, i+1, archiveFileName(p.name))
+		written, err := writeArchiveObject(store, archive, name, p.object)
+		if err != nil {
+			return entries, err
+		}
+		if written {
+			entries = append(entries, name)
+		}
+	}
+	return entries, nil
+}
+
+// writeArchiveObject copies one stored object into a new entry without buffering it,
+// reporting false when the object can't be opened
+func writeArchiveObject(store storage.BlobStore, archive *zip.Writer, name, object string) (bool, error) {
+	reader, err := store.Get(object)
+	if err != nil {
+		log.Printf("Archive: skipping %s: %v", object, err)
+		return false, nil
+	}
+	defer reader.Close()
+
+	entry, err := archive.Create(name)
+	if err != nil {
+		return false, err
+	}
+	if _, err := io.Copy(entry, reader); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func writeArchiveJSON(archive *zip.Writer, name string, value interface{}) error {
+	entry, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(entry)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// archiveFileName makes a user-supplied name safe to use inside a ZIP or a download header
+func archiveFileName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == '"' || r == '/' {
+			return '_'
+		}
+		return r
+	}, name)
+	if name == "" || name == "." || name == "/" {
+		return "file"
+	}
+	return name
+}
+
+// copyArchive is a PetArchive writer that streams a stored archive object
+func copyArchive(store storage.BlobStore, objectName string) func(w io.Writer) error {
+	return func(w io.Writer) error {
+		object, err := store.Get(objectName)
+		if err != nil {
+			return err
+		}
+		defer object.Close()
+		_, err = io.Copy(w, object)
+		return err
+	}
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"fmt"
+	"html"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Highlight markers passed to ts_headline; they can't appear in user text, so the
+// snippet can be HTML-escaped safely before they are swapped for <mark> tags
+const (
+	highlightStart = "\x02"
+	highlightStop  = "\x03"
+)
+
+// petListSortColumns maps the sort query values to columns and their default direction
+var petListSortColumns = map[string]struct {
+	column string
+	order  string
+}{
+	"name":          {"name", "asc"},
+	"date_of_birth": {"date_of_birth", "desc"},
+	"created_at":    {"created_at", "desc"},
+}
+
+// applyPetFilters narrows the pet list by everything in the query except the text search
+func applyPetFilters(query *gorm.DB, userInfo middleware.UserInfo, params dto.PetListQuery) *gorm.DB {
+	if params.Name != "" {
+		query = query.Where("name ILIKE ?", "%"+params.Name+"%")
+	}
+
+	if params.Type != "" {
+		query = query.Where("LOWER(type) = LOWER(?)", params.Type)
+	}
+
+	if params.Species != "" {
+		query = query.Where("species_code = ?", params.Species)
+	}
+
+	if params.Breed != "" {
+		query = query.Where("breed_code = ?", params.Breed)
+	}
+
+	if params.Gender != "" {
+		query = query.Where("gender = ?", params.Gender == "male")
+	}
+
+	// Ages are whole years: min_age=2 means born at least two years ago
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if params.MinAge != nil {
+		query = query.Where("date_of_birth <= ?", today.AddDate(-*params.MinAge, 0, 0))
+	}
+	if params.MaxAge != nil {
+		query = query.Where("date_of_birth > ?", today.AddDate(-(*params.MaxAge+1), 0, 0))
+	}
+
+	if params.OwnerID != "" {
+		ownerID := params.OwnerID
+		if ownerID == "me" {
+			ownerID = userInfo.UserID
+		}
+		query = query.Where("user_id = ?", ownerID)
+	}
+
+	switch params.Status {
+	case "alive":
+		query = query.Where("date_of_death IS NULL")
+	case "deceased":
+		query = query.Where("date_of_death IS NOT NULL")
+	}
+
+	if params.HasPhoto != nil {
+		hasPhoto := `(COALESCE(avt_url, '') <> '' OR EXISTS (
+				SELECT 1 FROM medias WHERE medias.pet_id = pets.id AND medias.is_active = true))`
+		if *params.HasPhoto {
+			query = query.Where(hasPhoto)
+		} else {
+			query = query.Where("NOT " + hasPhoto)
+		}
+	}
+
+	return query
+}
+
+// petListOrder builds the ORDER BY for the pet list. Relevance is only available
+// with a search and is the default then; otherwise the newest-born pets come first.
+func petListOrder(params dto.PetListQuery, tsquery string) clause.OrderBy {
+	sort := params.Sort
+	if sort == "" || (sort == "relevance" && tsquery == "") {
+		sort = "date_of_birth"
+		if tsquery != "" {
+			sort = "relevance"
+		}
+	}
+
+	if sort == "relevance" {
+		return clause.OrderBy{Expression: clause.Expr{
+			SQL:                "ts_rank(search_vector, to_tsquery('simple', ?)) DESC, name ASC, id ASC",
+			Vars:               []interface{}{tsquery},
+			WithoutParentheses: true,
+		}}
+	}
+
+	column := petListSortColumns[sort]
+	order := column.order
+	if params.Order != "" {
+		order = params.Order
+	}
+	orderBy := fmt.Sprintf("%s %s NULLS LAST, name ASC, id ASC", column.column, strings.ToUpper(order))
+	if column.column == "name" {
+		orderBy = fmt.Sprintf("name %s, id ASC", strings.ToUpper(order))
+	}
+	return clause.OrderBy{Expression: clause.Expr{SQL: orderBy, WithoutParentheses: true}}
+}
+
+// markHighlights escapes a ts_headline snippet and turns its markers into <mark> tags
+func markHighlights(snippet string) string {
+	snippet = html.EscapeString(snippet)
+	snippet = strings.ReplaceAll(snippet, highlightStart, "<mark>")
+	return strings.ReplaceAll(snippet, highlightStop, "</mark>")
+}
@@ -1,32 +1,62 @@
 package service
 
 import (
+	"encoding/json"
 	"errors"
-	"io"
-	"math"
+	"fmt"
+	"log"
+	"pet-service/config"
 	"pet-service/dto"
 	"pet-service/middleware"
 	"pet-service/models"
 	"pet-service/repository"
 	"pet-service/storage"
 	"pet-service/utils"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"gorm.io/gorm"
 )
 
 type petService struct {
-	petRepo repository.IPetRepository
+	petRepo        repository.IPetRepository
+	mediaRepo      repository.IMediaRepository
+	feedRepo       repository.IFeedRepository
+	catalogRepo    repository.ICatalogRepository
+	videoProcessor IVideoProcessor
+	store          storage.BlobStore
 }
 
 // NewPetService creates a new pet service instance
-func NewPetService(petRepo repository.IPetRepository) IPetService {
+func NewPetService(petRepo repository.IPetRepository, mediaRepo repository.IMediaRepository, feedRepo repository.IFeedRepository, catalogRepo repository.ICatalogRepository, videoProcessor IVideoProcessor, store storage.BlobStore) IPetService {
 	return &petService{
-		petRepo: petRepo,
+		petRepo:        petRepo,
+		mediaRepo:      mediaRepo,
+		feedRepo:       feedRepo,
+		catalogRepo:    catalogRepo,
+		videoProcessor: videoProcessor,
+		store:          store,
 	}
 }
 
 func (s *petService) CreatePet(userInfo middleware.UserInfo, req dto.PetCreateRequest) (*dto.PetResponse, error) {
+	catalog, err := resolvePetCatalog(s.catalogRepo, req.SpeciesCode, req.Type, req.BreedCode, req.Breed)
+	if err != nil {
+		return nil, err
+	}
+
+	pet := newPet(userInfo.UserID, req, catalog)
+	if err := s.petRepo.CreatePet(pet); err != nil {
+		return nil, err
+	}
+
+	return toPetResponse(s.store, pet, nil), nil
+}
+
+// newPet builds a pet owned by userID from a validated create request
+func newPet(userID string, req dto.PetCreateRequest, catalog *petCatalogMatch) *models.Pet {
 	dateOfBirth, _ := utils.ParseDateTime(req.DateOfBirth)
 	var dateOfDeath *time.Time
 	if req.DateOfDeath != "" {
@@ -38,65 +68,121 @@ func (s *petService) CreatePet(userInfo middleware.UserInfo, req dto.PetCreateRe
 		Gender:      req.Gender,
 		DateOfBirth: dateOfBirth,
 		DateOfDeath: dateOfDeath,
-		Breed:       req.Breed,
+		Breed:       catalog.Breed,
 		Description: req.Description,
-		Type:        req.Type,
-		UserID:      userInfo.UserID,
+		Type:        catalog.Type,
+		SpeciesCode: catalog.SpeciesCode,
+		BreedCode:   catalog.BreedCode,
+		Visibility:  req.Visibility,
+		UserID:      userID,
 	}
-	pet.CreatedBy = userInfo.UserID
-
-	if err := s.petRepo.CreatePet(pet); err != nil {
-		return nil, err
+	if pet.Visibility == "" {
+		pet.Visibility = utils.VisibilityPublic
 	}
+	pet.CreatedBy = userID
 
-	return &dto.PetResponse{
-		ID:   pet.ID,
-		Name: pet.Name,
-		Gender: pet.Gender,
-		DateOfBirth: pet.DateOfBirth.Format("2006-01-02"),
-		Breed: pet.Breed,
-		Description: pet.Description,
-		Type: pet.Type,
-	}, nil
+	return pet
 }
 
-func (s *petService) GetPets(db *gorm.DB, page, pageSize int, search, name string) (*dto.PaginationResponse, error) {
+func (s *petService) GetPets(db *gorm.DB, userInfo middleware.UserInfo, params dto.PetListQuery) (*dto.PaginationResponse, error) {
+	page, err := pageRequest(params.PageQuery)
+	if err != nil {
+		return nil, err
+	}
+	// Cursor pages follow the keyset (created_at, id), so no other ordering applies
+	if page.Keyset && ((params.Sort != "" && params.Sort != "created_at") || params.Order == "asc") {
+		return nil, errors.New(utils.CursorSortUnsupported)
+	}
+
 	query := db.Model(&models.Pet{}).Where("is_active = ?", true)
 
-	// Apply search filters
-	if search != "" {
-		query = query.Where("name ILIKE ? OR breed ILIKE ? OR type ILIKE ?",
-			"%"+search+"%", "%"+search+"%", "%"+search+"%")
+	// Only list pets the viewer is allowed to see
+	if !userInfo.IsAdmin {
+		query = query.Where(`visibility = ? OR user_id = ? OR (visibility = ? AND EXISTS (
+				SELECT 1 FROM pet_follows WHERE pet_follows.pet_id = pets.id AND pet_follows.user_id = ?
+				AND pet_follows.status = ? AND pet_follows.is_active = true)) OR EXISTS (
+				SELECT 1 FROM pet_members WHERE pet_members.pet_id = pets.id AND pet_members.user_id = ?
+				AND pet_members.status = ? AND pet_members.is_active = true)`,
+			utils.VisibilityPublic, userInfo.UserID, utils.VisibilityFollowers, userInfo.UserID, utils.FollowStatusApproved,
+			userInfo.UserID, utils.PetInviteAccepted)
 	}
 
-	if name != "" {
-		query = query.Where("name ILIKE ?", "%"+name+"%")
+	// Full-text search over name, breed and description (every word as a prefix);
+	// catalog names and aliases ("chó", "puppy") also match by code
+	tsquery := utils.PrefixTSQuery(params.Search)
+	if params.Search != "" {
+		normalized := utils.NormalizeTerm(params.Search)
+		speciesCodes, _ := s.catalogRepo.FindCodesByTerm(utils.CatalogEntitySpecies, normalized, "")
+		breedCodes, _ := s.catalogRepo.FindCodesByTerm(utils.CatalogEntityBreed, normalized, "")
+		query = query.Where("search_vector @@ to_tsquery('simple', ?) OR type ILIKE ? OR species_code IN ? OR breed_code IN ?",
+			tsquery, "%"+params.Search+"%", speciesCodes, breedCodes)
 	}
 
-	// Count total
-	var totalItem int64
-	query.Count(&totalItem)
-
-	// Calculate pagination
-	totalPage := int64(math.Ceil(float64(totalItem) / float64(pageSize)))
-	offset := (page - 1) * pageSize
+	query = applyPetFilters(query, userInfo, params)
 
 	// Get data with user preload
-	var pets []models.Pet
-	query.Preload("User").Order("date_of_birth DESC, name ASC").Limit(pageSize).Offset(offset).Find(&pets)
+	query = query.Preload("User").Preload("Members", "status = ? AND is_active = ?", utils.PetInviteAccepted, true)
+	pets, info, err := repository.Paginate[models.Pet](query, page, "pets.created_at", "pets.id", petListOrder(params, tsquery))
+	if err != nil {
+		return nil, err
+	}
+
+	petIDs := make([]string, 0, len(pets))
+	for _, pet := range pets {
+		petIDs = append(petIDs, pet.ID)
+	}
+	followedIDs, _ := s.feedRepo.GetFollowedPetIDs(userInfo.UserID, petIDs)
+	followed := make(map[string]bool, len(followedIDs))
+	for _, id := range followedIDs {
+		followed[id] = true
+	}
+
+	matches := make(map[string]*dto.PetSearchMatch)
+	if tsquery != "" {
+		rows, err := s.petRepo.GetSearchMatches(petIDs, tsquery, highlightStart, highlightStop)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			matches[row.ID] = &dto.PetSearchMatch{
+				Rank:        row.Rank,
+				Name:        markHighlights(row.Name),
+				Breed:       markHighlights(row.Breed),
+				Description: markHighlights(row.Description),
+			}
+		}
+	}
+
+	data := make([]dto.PetResponse, 0, len(pets))
+	for i := range pets {
+		access := petAccessLevel(&userInfo, &pets[i], followed[pets[i].ID])
+		response := toPetResponse(s.store, &pets[i], toPetOwnerResponse(&pets[i].User, access, followed[pets[i].ID]))
+		response.Match = matches[pets[i].ID]
+		data = append(data, *response)
+	}
 
 	return &dto.PaginationResponse{
-		Data: pets,
-		Meta: dto.PaginationMeta{
-			TotalItems: totalItem,
-			TotalPages: totalPage,
-			Page:       page,
-			PageSize:   pageSize,
-		},
+		Data: data,
+		Meta: pageMeta(page, info, pets, func(pet *models.Pet) (time.Time, string) {
+			return pet.CreatedAt, pet.ID
+		}),
 	}, nil
 }
 
-func (s *petService) GetPetDetail(petID string) (*dto.PetDetailResponse, error) {
+func (s *petService) GetPetDetail(viewer *middleware.UserInfo, petID string) (*dto.PetDetailResponse, error) {
+	pet, err := s.petRepo.GetPetWithOwner(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+
+	following := viewer != nil && s.feedRepo.IsFollowing(viewer.UserID, pet.ID)
+
+	// Hidden pets are reported as missing so their existence is not leaked
+	access := petAccessLevel(viewer, pet, following)
+	if access == petAccessNone {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+
 	results, err := s.petRepo.GetPetDetail(petID)
 	if err != nil || len(results) == 0 {
 		return nil, errors.New(utils.PetIDNotExist)
@@ -104,8 +190,22 @@ func (s *petService) GetPetDetail(petID string) (*dto.PetDetailResponse, error)
 
 	// Build response
 	response := dto.PetDetailResponse{
-		Events: []dto.PetLifeEventItem{},
-		Medias: []dto.MediaItem{},
+		Type:        pet.Type,
+		SpeciesCode: pet.SpeciesCode,
+		BreedCode:   pet.BreedCode,
+		AvtURL:      mediaURL(s.store, pet.AvtKey, pet.Visibility),
+		AvtVariants: mediaVariantURLs(s.store, pet.AvtVariants, pet.Visibility),
+		Visibility:  pet.Visibility,
+		IsMemorial:  isMemorial(pet),
+		Owner:       toPetOwnerResponse(&pet.User, access, following),
+		Events:      []dto.PetLifeEventItem{},
+		Medias:      []dto.MediaItem{},
+	}
+	if access >= petAccessCaretaker {
+		if latest, err := s.petRepo.GetLatestMeasurement(petID); err == nil {
+			item := toMeasurementItem(latest)
+			response.LatestMeasurement = &item
+		}
 	}
 
 	eventIDs := make(map[string]bool)
@@ -152,19 +252,153 @@ func (s *petService) GetPetDetail(petID string) (*dto.PetDetailResponse, error)
 
 		// Add medias
 		if mediaID, ok := r["media_id"].(string); ok && mediaID != "" && !mediaIDs[mediaID] {
+			key, _ := r["media_url"].(string)
 			media := dto.MediaItem{
-				ID:  mediaID,
-				URL: r["media_url"].(string),
+				ID:     mediaID,
+				URL:    mediaURL(s.store, key, pet.Visibility),
+				Status: utils.MediaStatusReady,
+			}
+			if mediaType, ok := r["media_type"].(string); ok {
+				media.Type = mediaType
+			}
+			if status, ok := r["media_status"].(string); ok {
+				media.Status = status
+			}
+			if variants, ok := r["media_variants"].(string); ok && variants != "" {
+				var keys map[string]string
+				_ = json.Unmarshal([]byte(variants), &keys)
+				media.Variants = mediaVariantURLs(s.store, keys, pet.Visibility)
+			}
+			if media.Type == utils.MediaTypeVideo {
+				if duration, ok := r["media_duration"].(float64); ok {
+					media.Duration = duration
+				}
+				if width, ok := r["media_width"].(int64); ok {
+					media.Width = int(width)
+				}
+				if height, ok := r["media_height"].(int64); ok {
+					media.Height = int(height)
+				}
+				media.PlaybackURL = playbackURL(s.store, key)
+			}
+			if caption, ok := r["media_caption"].(string); ok {
+				media.Caption = caption
+			}
+			if albumID, ok := r["media_album_id"].(string); ok {
+				media.AlbumID = albumID
 			}
 			response.Medias = append(response.Medias, media)
 			mediaIDs[mediaID] = true
 		}
 	}
 
+	for i := range response.Medias {
+		if response.Medias[i].ID == pet.CoverMediaID {
+			response.Cover = &response.Medias[i]
+		}
+	}
+
 	return &response, nil
 }
 
+func (s *petService) UpdatePet(userInfo middleware.UserInfo, petID string, req dto.PetUpdateRequest) (*dto.PetResponse, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+
+	if resolvePetAccess(s.feedRepo, &userInfo, pet) != petAccessOwner {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	if req.Type != nil || req.SpeciesCode != nil || req.Breed != nil || req.BreedCode != nil {
+		speciesCode, typeText := pet.SpeciesCode, pet.Type
+		if req.Type != nil || req.SpeciesCode != nil {
+			speciesCode, typeText = derefString(req.SpeciesCode), derefString(req.Type)
+		}
+		breedCode, breedText := pet.BreedCode, pet.Breed
+		if req.Breed != nil || req.BreedCode != nil {
+			breedCode, breedText = derefString(req.BreedCode), derefString(req.Breed)
+		}
+
+		catalog, err := resolvePetCatalog(s.catalogRepo, speciesCode, typeText, breedCode, breedText)
+		if err != nil && req.Breed == nil && req.BreedCode == nil && err.Error() == utils.InvalidBreed {
+			// The species changed and the old breed does not belong to it
+			catalog, err = resolvePetCatalog(s.catalogRepo, speciesCode, typeText, "", "")
+		}
+		if err != nil {
+			return nil, err
+		}
+		pet.SpeciesCode, pet.Type = catalog.SpeciesCode, catalog.Type
+		pet.BreedCode, pet.Breed = catalog.BreedCode, catalog.Breed
+	}
+
+	if req.Name != nil {
+		pet.Name = *req.Name
+	}
+	if req.Gender != nil {
+		pet.Gender = *req.Gender
+	}
+	if req.Description != nil {
+		pet.Description = *req.Description
+	}
+	if req.DateOfBirth != nil {
+		if pet.DateOfBirth, err = parseOptionalDate(*req.DateOfBirth); err != nil {
+			return nil, err
+		}
+	}
+	if req.DateOfDeath != nil {
+		if pet.DateOfDeath, err = parseOptionalDate(*req.DateOfDeath); err != nil {
+			return nil, err
+		}
+		// A memorial profile leaves lost mode
+		if isMemorial(pet) {
+			clearLostState(pet)
+		}
+	}
+
+	now := time.Now()
+	pet.UpdatedAt = &now
+	pet.UpdatedBy = userInfo.UserID
+
+	if err := s.petRepo.UpdatePet(pet); err != nil {
+		return nil, err
+	}
+
+	return toPetResponse(s.store, pet, nil), nil
+}
+
+func (s *petService) UpdateVisibility(userInfo middleware.UserInfo, petID string, req dto.PetVisibilityRequest) (*dto.PetResponse, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+
+	if resolvePetAccess(s.feedRepo, &userInfo, pet) != petAccessOwner {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
+	pet.Visibility = req.Visibility
+	now := time.Now()
+	pet.UpdatedAt = &now
+	pet.UpdatedBy = userInfo.UserID
+
+	if err := s.petRepo.UpdatePet(pet); err != nil {
+		return nil, err
+	}
+
+	return toPetResponse(s.store, pet, nil), nil
+}
+
 func (s *petService) CreatePetLifeEvent(userInfo middleware.UserInfo, req dto.PetLifeEventRequest) (*dto.PetLifeEventResponse, error) {
+	pet, err := s.petRepo.GetPetByID(req.PetID)
+	if err != nil {
+		return nil, errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) < petAccessCaretaker {
+		return nil, errors.New(utils.PermissionDenied)
+	}
+
 	date, _ := utils.ParseDateTime(req.Date)
 
 	event := &models.PetLifeEvent{
@@ -180,6 +414,8 @@ func (s *petService) CreatePetLifeEvent(userInfo middleware.UserInfo, req dto.Pe
 		return nil, err
 	}
 
+	recordActivity(s.feedRepo, userInfo.UserID, event.PetID, utils.ActivityLifeEvent, event.ID, event.Title, event.CreatedAt)
+
 	return &dto.PetLifeEventResponse{
 		ID:     event.ID,
 		PetID:  event.PetID,
@@ -188,76 +424,362 @@ func (s *petService) CreatePetLifeEvent(userInfo middleware.UserInfo, req dto.Pe
 	}, nil
 }
 
-func (s *petService) UploadAvatar(petID string, fileData []byte, contentType string) (*dto.MediaResponse, error) {
+func (s *petService) UploadAvatar(userInfo middleware.UserInfo, petID string, file UploadFile) (*dto.MediaResponse, error) {
 	pet, err := s.petRepo.GetPetByID(petID)
 	if err != nil {
 		return nil, errors.New(utils.PetIDNotExist)
 	}
+	if petAccessLevel(&userInfo, pet, false) != petAccessOwner {
+		return nil, errors.New(utils.PermissionDenied)
+	}
 
-	imageID := utils.GenerateUUID()
-	objectName := "pet/" + petID + "/" + imageID
-
-	minioClient := storage.GetMinioClient()
-	url, err := minioClient.UploadFile(objectName, fileData, contentType)
-	if err != nil {
+	if err := checkUpload(&file, "file", utils.ImageContentTypes); err != nil {
 		return nil, err
 	}
 
-	pet.AvtURL = url
-	if err := s.petRepo.UpdatePet(pet); err != nil {
+	// The current avatar stays until the new one has been processed
+	imageID, err := uploadIncomingImage(s.store, file)
+	if err != nil {
 		return nil, err
 	}
+	go s.processAvatar(petID, imageID)
 
 	return &dto.MediaResponse{
-		ID:  imageID,
-		URL: url,
+		ID:     imageID,
+		Type:   utils.MediaTypeImage,
+		Status: utils.MediaStatusProcessing,
 	}, nil
 }
 
-func (s *petService) UploadGallery(petID string, files []io.Reader, fileNames []string, contentTypes []string) ([]dto.MediaResponse, error) {
-	_, err := s.petRepo.GetPetByID(petID)
+// processAvatar strips and resizes an uploaded avatar, then makes it the pet's avatar
+func (s *petService) processAvatar(petID, imageID string) {
+	defer func() {
+		// A panic must not take the server down; the current avatar stays
+		if r := recover(); r != nil {
+			log.Printf("Processing avatar %s for pet %s panicked: %v", imageID, petID, r)
+		}
+	}()
+
+	key := petObjectName(petID, imageID)
+	variants, err := processIncomingImage(s.store, imageID, key)
+	if err != nil {
+		log.Printf("Failed to process avatar %s for pet %s: %v", imageID, petID, err)
+		return
+	}
+	if err := s.petRepo.UpdatePetAvatar(petID, key, variants); err != nil {
+		log.Printf("Failed to save avatar %s for pet %s: %v", imageID, petID, err)
+	}
+}
+
+func (s *petService) UploadGallery(userInfo middleware.UserInfo, petID string, files []UploadFile) ([]dto.MediaResponse, error) {
+	pet, err := s.petRepo.GetPetByID(petID)
 	if err != nil {
 		return nil, errors.New(utils.PetIDNotExist)
 	}
+	if petAccessLevel(&userInfo, pet, false) < petAccessCaretaker {
+		return nil, errors.New(utils.PermissionDenied)
+	}
 
-	minioClient := storage.GetMinioClient()
-	var medias []models.Media
+	// Every file must pass before any is stored. Videos are staged on disk so they can be
+	// probed; staged files not handed to the poster step are removed on return.
+	allowed := append(slices.Clone(utils.ImageContentTypes), utils.VideoContentTypes...)
+	videos := make([]*stagedVideo, len(files))
+	defer func() {
+		for _, video := range videos {
+			if video != nil {
+				video.remove()
+			}
+		}
+	}()
+	for i := range files {
+		if err := checkUpload(&files[i], "files", allowed); err != nil {
+			return nil, err
+		}
 
-	for i, file := range files {
-		mediaID := utils.GenerateUUID()
-		objectName := "pet/" + petID + "/" + mediaID
+		if files[i].Size > mediaMaxFileSize(files[i].ContentType) {
+			return nil, &utils.FileValidationError{Field: "files", File: files[i].Name, Message: utils.UploadFileTooLarge}
+		}
 
-		// Read file data
-		fileData, err := io.ReadAll(file)
-		if err != nil {
-			continue
+		if utils.MediaKind(files[i].ContentType) == utils.MediaTypeVideo {
+			video, err := stageVideo(s.videoProcessor, &files[i], "files")
+			if err != nil {
+				return nil, err
+			}
+			videos[i] = video
 		}
+	}
 
-		url, err := minioClient.UploadFile(objectName, fileData, contentTypes[i])
-		if err != nil {
-			continue
+	// New items go to the end of the gallery, in the order they were sent
+	position, err := s.petRepo.GetNextMediaPosition(petID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stream at most UploadConcurrency files to storage at once, hashing them on the way;
+	// if any fails, the others are discarded and the request fails. Photos wait in incoming/ until the image pipeline has
+	// stripped and resized them. Videos are stored once per content, and stay processing
+	// until their poster frame has been extracted unless the same file was stored before.
+	uploaded := make([]*models.Media, len(files))
+	posters := make([]bool, len(files))
+	var failed atomic.Bool
+	slots := make(chan struct{}, config.AppConfig.UploadConcurrency)
+	var wg sync.WaitGroup
+	for i := range files {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			media := &models.Media{
+				Type:   utils.MediaKind(files[i].ContentType),
+				Name:   files[i].Name,
+				Status: utils.MediaStatusProcessing,
+				PetID:  petID,
+			}
+
+			var err error
+			if media.Type == utils.MediaTypeImage {
+				contentHash := hashUpload(&files[i])
+				media.ID, err = uploadIncomingImage(s.store, files[i])
+				media.ContentHash = contentHash()
+			} else {
+				media.ID = utils.GenerateUUID()
+				posters[i], err = s.storeGalleryVideo(media, videos[i], files[i].Size, files[i].ContentType)
+			}
+			if err != nil {
+				log.Printf("Failed to upload gallery file %s for pet %s: %v", files[i].Name, petID, err)
+				failed.Store(true)
+				return
+			}
+
+			media.CreatedBy = userInfo.UserID
+			uploaded[i] = media
+		}(i)
+	}
+	wg.Wait()
+
+	discardAll := func() {
+		for _, media := range uploaded {
+			if media != nil {
+				s.discardGalleryUpload(media)
+			}
+		}
+	}
+	if failed.Load() {
+		discardAll()
+		return nil, errors.New(utils.UploadStoreFailed)
+	}
+
+	// A file the pet already has, or that was sent twice, gives back the item holding it
+	var hashes []string
+	for _, media := range uploaded {
+		hashes = append(hashes, media.ContentHash)
+	}
+	existing, err := s.mediaRepo.GetMediasByContentHash(petID, hashes)
+	if err != nil {
+		discardAll()
+		return nil, err
+	}
+	held := make(map[string]*models.Media, len(existing))
+	for i := range existing {
+		if held[existing[i].ContentHash] == nil {
+			held[existing[i].ContentHash] = &existing[i]
 		}
+	}
 
-		media := models.Media{
-			Name:  fileNames[i],
-			URL:   url,
-			PetID: petID,
+	var medias []models.Media
+	var sources []int // index in files of each new media
+	var results []*models.Media
+	for i, media := range uploaded {
+		if item := held[media.ContentHash]; item != nil {
+			s.discardGalleryUpload(media)
+			if !slices.Contains(results, item) {
+				results = append(results, item)
+			}
+			continue
 		}
-		media.ID = mediaID
-		medias = append(medias, media)
+
+		media.Position = position + len(medias)
+		held[media.ContentHash] = media
+		results = append(results, media)
+		medias = append(medias, *media)
+		sources = append(sources, i)
 	}
 
 	if err := s.petRepo.CreateMediaBatch(medias); err != nil {
+		for i := range medias {
+			s.discardGalleryUpload(&medias[i])
+		}
 		return nil, err
 	}
+	for i, media := range medias {
+		if media.Type == utils.MediaTypeImage {
+			go s.processGalleryImage(media)
+		} else if posters[sources[i]] {
+			go s.processGalleryVideo(media, videos[sources[i]])
+			videos[sources[i]] = nil
+		}
+	}
 
-	var response []dto.MediaResponse
-	for _, media := range medias {
-		response = append(response, dto.MediaResponse{
-			ID:  media.ID,
-			URL: media.URL,
-		})
+	if len(medias) > 0 {
+		summary := fmt.Sprintf("%d new photos", len(medias))
+		if len(medias) == 1 {
+			summary = "1 new photo"
+		}
+		recordActivity(s.feedRepo, userInfo.UserID, petID, utils.ActivityMedia, medias[0].ID, summary, time.Now())
+	}
+
+	response := make([]dto.MediaResponse, 0, len(results))
+	for _, media := range results {
+		response = append(response, toUploadedMediaResponse(s.store, media, pet.Visibility))
 	}
 
 	return response, nil
 }
+
+// processGalleryImage attaches an uploaded gallery photo to the blob of its content,
+// stripping and resizing it unless the same file is stored already, and marks it ready,
+// or failed when the file can't be processed
+func (s *petService) processGalleryImage(media models.Media) {
+	acquired := false
+	defer func() {
+		// A panic must not take the server down, nor leave the item processing
+		if r := recover(); r != nil {
+			log.Printf("Processing gallery photo %s panicked: %v", media.ID, r)
+			if failProcessingMedia(s.mediaRepo, s.store, &media) && acquired {
+				s.releaseMediaBlob(media.ContentHash)
+			}
+		}
+	}()
+
+	blob, err := s.acquireMediaBlob(media.ContentHash)
+	if err == nil {
+		acquired = true
+		if blob.Ready {
+			removeIncomingImage(s.store, media.ID)
+		} else {
+			blob.Variants, err = processIncomingImage(s.store, media.ID, blob.Key)
+			if err == nil {
+				err = s.mediaRepo.MarkMediaBlobReady(blob)
+			}
+			if err != nil {
+				acquired = false
+				s.releaseMediaBlob(media.ContentHash)
+			}
+		}
+	}
+	if err != nil {
+		log.Printf("Failed to process gallery photo %s for pet %s: %v", media.ID, media.PetID, err)
+		media.Status = utils.MediaStatusFailed
+	} else {
+		media.Key = blob.Key
+		media.Variants = blob.Variants
+		media.Status = utils.MediaStatusReady
+	}
+
+	now := time.Now()
+	media.UpdatedAt = &now
+	saved, err := s.petRepo.UpdateMedia(&media)
+	if err != nil {
+		log.Printf("Failed to save gallery photo %s: %v", media.ID, err)
+	}
+	// The job sweep failed the item meanwhile, so it no longer holds the blob
+	if err == nil && !saved && acquired {
+		acquired = false
+		s.releaseMediaBlob(media.ContentHash)
+	}
+}
+
+// processGalleryVideo renders a poster frame and its variants for an uploaded video and
+// marks it and its blob ready. The video itself is already stored and stays playable, so
+// a poster that can't be extracted only costs the thumbnail.
+func (s *petService) processGalleryVideo(media models.Media, video *stagedVideo) {
+	defer video.remove()
+	defer func() {
+		// A panic must not take the server down, nor leave the item processing
+		if r := recover(); r != nil {
+			log.Printf("Processing gallery video %s panicked: %v", media.ID, r)
+			failProcessingMedia(s.mediaRepo, s.store, &media)
+		}
+	}()
+
+	poster, err := s.videoProcessor.Poster(video.file.Name(), posterTime(video.info.Duration))
+	if err == nil {
+		posterKey := videoPosterObjectName(media.Key)
+		media.Variants, err = storeProcessedImage(s.store, poster, posterKey)
+		if err == nil {
+			media.Variants["poster"] = posterKey
+		}
+	}
+	if err != nil {
+		log.Printf("Failed to extract poster for gallery video %s of pet %s: %v", media.ID, media.PetID, err)
+	}
+
+	// An item the job sweep failed meanwhile has given back its blob, so it is left alone
+	media.Status = utils.MediaStatusReady
+	now := time.Now()
+	media.UpdatedAt = &now
+	saved, err := s.petRepo.UpdateMedia(&media)
+	if err != nil {
+		log.Printf("Failed to save gallery video %s: %v", media.ID, err)
+	}
+	if !saved {
+		return
+	}
+
+	blob := &models.MediaBlob{Hash: media.ContentHash, Key: media.Key, Variants: media.Variants}
+	if err := s.mediaRepo.MarkMediaBlobReady(blob); err != nil {
+		log.Printf("Failed to save blob of gallery video %s: %v", media.ID, err)
+	}
+}
+
+// FailStaleMedias fails gallery items whose processing was lost to a restart, see
+// BACKGROUND_JOB_TIMEOUT_MINUTES, and removes the blobs nothing holds any more
+func (s *petService) FailStaleMedias() {
+	count, unreferenced, err := s.mediaRepo.FailStaleMedias(time.Now().Add(-config.AppConfig.BackgroundJobTimeout))
+	if err != nil {
+		log.Printf("Job sweep: failed to check gallery items: %v", err)
+		return
+	}
+	for _, hash := range unreferenced {
+		removeMediaBlob(s.mediaRepo, s.store, hash)
+	}
+	if count > 0 {
+		log.Printf("Job sweep: marked %d interrupted gallery items failed", count)
+	}
+}
+
+// toPetResponse maps a pet to its public DTO; owner is optional
+func toPetResponse(store storage.BlobStore, pet *models.Pet, owner *dto.PetOwnerResponse) *dto.PetResponse {
+	response := &dto.PetResponse{
+		ID:          pet.ID,
+		Name:        pet.Name,
+		Gender:      pet.Gender,
+		Breed:       pet.Breed,
+		Description: pet.Description,
+		Type:        pet.Type,
+		SpeciesCode: pet.SpeciesCode,
+		BreedCode:   pet.BreedCode,
+		AvtURL:      mediaURL(store, pet.AvtKey, pet.Visibility),
+		AvtVariants: mediaVariantURLs(store, pet.AvtVariants, pet.Visibility),
+		Visibility:  pet.Visibility,
+		IsMemorial:  isMemorial(pet),
+		Owner:       owner,
+	}
+	if pet.DateOfBirth != nil {
+		response.DateOfBirth = pet.DateOfBirth.Format("2006-01-02")
+	}
+	if pet.DateOfDeath != nil {
+		response.DateOfDeath = pet.DateOfDeath.Format("2006-01-02")
+	}
+
+	return response
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
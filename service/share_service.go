@@ -0,0 +1,191 @@
+package service
+
+import (
+	"errors"
+	"pet-service/config"
+	"pet-service/dto"
+	"pet-service/middleware"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/storage"
+	"pet-service/utils"
+	"time"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+const (
+	qrCodeDefaultSize = 512
+	qrCodeMinSize     = 128
+	qrCodeMaxSize     = 2048
+)
+
+type shareService struct {
+	petRepo repository.IPetRepository
+	store   storage.BlobStore
+}
+
+// NewShareService creates a new share link service instance
+func NewShareService(petRepo repository.IPetRepository, store storage.BlobStore) IShareService {
+	return &shareService{
+		petRepo: petRepo,
+		store:   store,
+	}
+}
+
+func (s *shareService) GetShareLink(userInfo middleware.UserInfo, petID string) (*dto.ShareLinkResponse, error) {
+	if err := s.checkPetOwner(userInfo, petID); err != nil {
+		return nil, err
+	}
+
+	link, err := s.activeOrNewLink(userInfo, petID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toShareLinkResponse(link), nil
+}
+
+func (s *shareService) RotateShareLink(userInfo middleware.UserInfo, petID string) (*dto.ShareLinkResponse, error) {
+	if err := s.checkPetOwner(userInfo, petID); err != nil {
+		return nil, err
+	}
+
+	if err := s.revokeActiveLink(userInfo, petID); err != nil {
+		return nil, err
+	}
+
+	link, err := s.createLink(userInfo, petID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toShareLinkResponse(link), nil
+}
+
+func (s *shareService) RevokeShareLink(userInfo middleware.UserInfo, petID string) (*dto.MessageResponse, error) {
+	if err := s.checkPetOwner(userInfo, petID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.petRepo.GetActiveShareLink(petID); err != nil {
+		return nil, errors.New(utils.ShareLinkNotExist)
+	}
+
+	if err := s.revokeActiveLink(userInfo, petID); err != nil {
+		return nil, err
+	}
+
+	return &dto.MessageResponse{
+		Message: "Share link revoked",
+	}, nil
+}
+
+func (s *shareService) GetQRCode(userInfo middleware.UserInfo, petID string, size int) ([]byte, error) {
+	if err := s.checkPetOwner(userInfo, petID); err != nil {
+		return nil, err
+	}
+
+	link, err := s.activeOrNewLink(userInfo, petID)
+	if err != nil {
+		return nil, err
+	}
+
+	if size <= 0 {
+		size = qrCodeDefaultSize
+	}
+	if size < qrCodeMinSize {
+		size = qrCodeMinSize
+	}
+	if size > qrCodeMaxSize {
+		size = qrCodeMaxSize
+	}
+
+	// High recovery level so a scratched collar tag still scans
+	return qrcode.Encode(shareURL(link.Slug), qrcode.High, size)
+}
+
+func (s *shareService) GetPublicProfile(slug string) (*dto.PublicPetProfileResponse, error) {
+	link, err := s.petRepo.GetShareLinkBySlug(slug)
+	if err != nil || !link.Pet.IsActive {
+		return nil, errors.New(utils.ShareLinkNotExist)
+	}
+
+	pet := link.Pet
+	// The slug itself is the capability, so only fields the owner made public are shown
+	return &dto.PublicPetProfileResponse{
+		Name:   pet.Name,
+		Type:   pet.Type,
+		Breed:  pet.Breed,
+		Gender: pet.Gender,
+		AvtURL: mediaURL(s.store, pet.AvtKey, pet.Visibility),
+		IsLost: pet.IsLost,
+		Owner:  toPetOwnerResponse(&pet.User, petAccessView, false),
+	}, nil
+}
+
+func (s *shareService) checkPetOwner(userInfo middleware.UserInfo, petID string) error {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil {
+		return errors.New(utils.PetIDNotExist)
+	}
+	if petAccessLevel(&userInfo, pet, false) != petAccessOwner {
+		return errors.New(utils.PermissionDenied)
+	}
+	return nil
+}
+
+func (s *shareService) activeOrNewLink(userInfo middleware.UserInfo, petID string) (*models.PetShareLink, error) {
+	link, err := s.petRepo.GetActiveShareLink(petID)
+	if err == nil {
+		return link, nil
+	}
+	return s.createLink(userInfo, petID)
+}
+
+func (s *shareService) createLink(userInfo middleware.UserInfo, petID string) (*models.PetShareLink, error) {
+	slug, err := utils.GenerateSlug()
+	if err != nil {
+		return nil, err
+	}
+
+	link := &models.PetShareLink{
+		PetID: petID,
+		Slug:  slug,
+	}
+	link.CreatedBy = userInfo.UserID
+
+	if err := s.petRepo.CreateShareLink(link); err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+func (s *shareService) revokeActiveLink(userInfo middleware.UserInfo, petID string) error {
+	link, err := s.petRepo.GetActiveShareLink(petID)
+	if err != nil {
+		return nil
+	}
+
+	now := time.Now()
+	link.IsActive = false
+	link.RevokedAt = &now
+	link.UpdatedAt = &now
+	link.UpdatedBy = userInfo.UserID
+	return s.petRepo.UpdateShareLink(link)
+}
+
+// shareURL is the web page a QR tag points to
+func shareURL(slug string) string {
+	return config.AppConfig.PublicWebURL + "/p/" + slug
+}
+
+func toShareLinkResponse(link *models.PetShareLink) *dto.ShareLinkResponse {
+	return &dto.ShareLinkResponse{
+		PetID:     link.PetID,
+		Slug:      link.Slug,
+		URL:       shareURL(link.Slug),
+		QRCodeURL: "/api/v1/pet/" + link.PetID + "/qr.png",
+		CreatedAt: link.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
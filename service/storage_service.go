@@ -0,0 +1,197 @@
+package service
+
+import (
+	"log"
+	"pet-service/config"
+	"pet-service/models"
+	"pet-service/repository"
+	"pet-service/storage"
+	"pet-service/utils"
+	"strings"
+	"time"
+)
+
+// reconcileLogLimit caps how many orphaned or missing objects a run logs one by one
+const reconcileLogLimit = 100
+
+// reconcilePrefixes are the parts of storage the database accounts for. Gallery files
+// are under media/, photos waiting for the image pipeline sit in incoming/; exports have
+// their own expiry.
+var reconcilePrefixes = []string{petObjectPrefix, mediaBlobPrefix, incomingObjectName("")}
+
+type storageService struct {
+	storageRepo repository.IStorageRepository
+	store       storage.BlobStore
+}
+
+// NewStorageService creates a new storage maintenance service instance
+func NewStorageService(storageRepo repository.IStorageRepository, store storage.BlobStore) IStorageService {
+	return &storageService{
+		storageRepo: storageRepo,
+		store:       store,
+	}
+}
+
+// storageRefs is what the database says should be in storage
+type storageRefs struct {
+	keys    map[string]string // key -> row that refers to it, for reports
+	pending []string          // prefixes of gallery items still being processed
+}
+
+// add records a key; links from before keys were stored are not in the bucket and are skipped
+func (r *storageRefs) add(key, owner string) {
+	if strings.HasPrefix(key, petObjectPrefix) || strings.HasPrefix(key, mediaBlobPrefix) {
+		r.keys[key] = owner
+	}
+}
+
+// covers reports whether an object is referred to or belongs to an item still processing
+func (r *storageRefs) covers(key string) bool {
+	if _, ok := r.keys[key]; ok {
+		return true
+	}
+	for _, prefix := range r.pending {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReconcileStorage compares the objects under pet/, media/ and incoming/ with the keys
+// stored on gallery items and their blobs, avatars and sightings. Objects nothing refers
+// to (left by failed uploads, replaced avatars and the like) are deleted once they are
+// older than STORAGE_ORPHAN_GRACE_HOURS, which covers uploads whose rows aren't written
+// yet. Rows pointing at missing objects are only reported. With STORAGE_RECONCILE_DRY_RUN
+// the orphans are reported instead of deleted. It runs on STORAGE_RECONCILE_CRON.
+func (s *storageService) ReconcileStorage() {
+	dryRun := config.AppConfig.StorageReconcileDryRun
+	cutoff := time.Now().Add(-config.AppConfig.StorageOrphanGrace)
+
+	// List before loading references: an object stored in between is newer than the cutoff
+	var objects []storage.ObjectInfo
+	for _, prefix := range reconcilePrefixes {
+		listed, err := s.store.List(prefix)
+		if err != nil {
+			log.Printf("Storage reconcile: failed to list %s: %v", prefix, err)
+			return
+		}
+		objects = append(objects, listed...)
+	}
+	refs, err := s.loadStorageRefs()
+	if err != nil {
+		log.Printf("Storage reconcile: failed to load references: %v", err)
+		return
+	}
+
+	stored := make(map[string]bool, len(objects))
+	orphans, deleted := 0, 0
+	for _, object := range objects {
+		stored[object.Key] = true
+		if refs.covers(object.Key) || object.LastModified.After(cutoff) {
+			continue
+		}
+
+		orphans++
+		if dryRun {
+			if orphans <= reconcileLogLimit {
+				log.Printf("Storage reconcile: would delete orphan %s (%d bytes, %s)", object.Key, object.Size, object.LastModified.Format(time.RFC3339))
+			}
+			continue
+		}
+		if err := s.store.Delete(object.Key); err != nil {
+			log.Printf("Storage reconcile: failed to delete orphan %s: %v", object.Key, err)
+			continue
+		}
+		deleted++
+	}
+
+	missing := 0
+	for key, owner := range refs.keys {
+		if stored[key] {
+			continue
+		}
+		missing++
+		if missing <= reconcileLogLimit {
+			log.Printf("Storage reconcile: %s points at missing object %s", owner, key)
+		}
+	}
+
+	if dryRun {
+		log.Printf("Storage reconcile (dry run): %d objects scanned, %d orphans would be deleted, %d missing objects", len(objects), orphans, missing)
+		return
+	}
+	log.Printf("Storage reconcile: %d objects scanned, %d of %d orphans deleted, %d missing objects", len(objects), deleted, orphans, missing)
+}
+
+// loadStorageRefs collects every object key stored on gallery items, blobs, avatars and
+// sightings, and the uploads that may still be completed
+func (s *storageService) loadStorageRefs() (*storageRefs, error) {
+	refs := &storageRefs{keys: make(map[string]string)}
+
+	medias, err := s.storageRepo.GetMediaObjects()
+	if err != nil {
+		return nil, err
+	}
+	for _, media := range medias {
+		// A photo being processed has no keys yet, and a video's poster is still being
+		// written, so the upload and whatever is stored under the item's key are kept
+		if media.Status == utils.MediaStatusProcessing {
+			refs.pending = append(refs.pending, petObjectName(media.PetID, media.ID), incomingObjectName(media.ID))
+			continue
+		}
+		addObjectRefs(refs, "media "+media.ID, media.Key, media.Variants)
+	}
+
+	blobs, err := s.storageRepo.GetMediaBlobs()
+	if err != nil {
+		return nil, err
+	}
+	for _, blob := range blobs {
+		// A blob nobody holds is being removed. One still being stored is written under
+		// its key by whoever is processing it.
+		if blob.RefCount == 0 {
+			continue
+		}
+		if !blob.Ready {
+			refs.pending = append(refs.pending, blob.Key)
+			continue
+		}
+		addObjectRefs(refs, "blob "+blob.Hash, blob.Key, blob.Variants)
+	}
+
+	// A posted file waits in incoming/ until its upload is completed, however long the
+	// policy lets that take
+	uploadIDs, err := s.storageRepo.GetOpenUploadIDs(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range uploadIDs {
+		refs.pending = append(refs.pending, incomingObjectName(id))
+	}
+
+	pets, err := s.storageRepo.GetPetAvatars()
+	if err != nil {
+		return nil, err
+	}
+	for _, pet := range pets {
+		addObjectRefs(refs, "avatar of pet "+pet.ID, pet.AvtKey, pet.AvtVariants)
+	}
+
+	sightings, err := s.storageRepo.GetSightingPhotos()
+	if err != nil {
+		return nil, err
+	}
+	for _, sighting := range sightings {
+		addObjectRefs(refs, "sighting "+sighting.ID, sighting.PhotoKey, sighting.PhotoVariants)
+	}
+
+	return refs, nil
+}
+
+func addObjectRefs(refs *storageRefs, owner, key string, variants models.ImageVariants) {
+	refs.add(key, owner)
+	for _, variantKey := range variants {
+		refs.add(variantKey, owner)
+	}
+}
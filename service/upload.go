@@ -0,0 +1,41 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"pet-service/utils"
+	"slices"
+)
+
+// mediaNameMaxLen matches the size of the medias.name column
+const mediaNameMaxLen = 105
+
+// checkUpload sniffs a file's leading bytes and rejects it unless the detected type is
+// allowed and agrees with the type the client declared. The sniffed bytes are put back
+// in front of the reader, ContentType becomes the detected type and Name is sanitized.
+func checkUpload(file *UploadFile, field string, allowed []string) error {
+	head := make([]byte, utils.SniffLen)
+	n, err := io.ReadFull(file.Reader, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return errors.New(utils.UploadFileUnreadable)
+	}
+	head = head[:n]
+
+	file.Name = utils.SanitizeFileName(file.Name, mediaNameMaxLen)
+
+	detected := utils.SniffContentType(head)
+	if !slices.Contains(allowed, detected) {
+		return &utils.FileValidationError{Field: field, File: file.Name, Message: utils.UploadTypeNotAllowed}
+	}
+
+	// A missing or generic declared type is fine; a specific one must match the bytes
+	declared := utils.NormalizeContentType(file.ContentType)
+	if declared != "" && declared != "application/octet-stream" && declared != detected {
+		return &utils.FileValidationError{Field: field, File: file.Name, Message: utils.UploadTypeMismatch}
+	}
+
+	file.Reader = io.MultiReader(bytes.NewReader(head), file.Reader)
+	file.ContentType = detected
+	return nil
+}
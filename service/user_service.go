@@ -13,12 +13,16 @@ import (
 
 type userService struct {
 	userRepo repository.IUserRepository
+	petRepo  repository.IPetRepository
+	feedRepo repository.IFeedRepository
 }
 
 // NewUserService creates a new user service instance
-func NewUserService(userRepo repository.IUserRepository) IUserService {
+func NewUserService(userRepo repository.IUserRepository, petRepo repository.IPetRepository, feedRepo repository.IFeedRepository) IUserService {
 	return &userService{
 		userRepo: userRepo,
+		petRepo:  petRepo,
+		feedRepo: feedRepo,
 	}
 }
 
@@ -187,15 +191,17 @@ func (s *userService) GetMe(userInfo middleware.UserInfo) (*dto.UserResponse, er
 	}
 
 	return &dto.UserResponse{
-		ID:          user.ID,
-		FirstName:   user.FirstName,
-		LastName:    user.LastName,
-		Email:       user.Email,
-		Phone:       user.Phone,
-		Avatar:      user.AvatarURL,
-		IsAdmin:     user.IsAdmin,
-		Roles:       roles,
-		Permissions: perms,
+		ID:              user.ID,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		Email:           user.Email,
+		Phone:           user.Phone,
+		Avatar:          user.AvatarURL,
+		IsAdmin:         user.IsAdmin,
+		EmailVisibility: user.EmailVisibility,
+		PhoneVisibility: user.PhoneVisibility,
+		Roles:           roles,
+		Permissions:     perms,
 	}, nil
 }
 
@@ -227,25 +233,47 @@ func (s *userService) Logout(userInfo middleware.UserInfo) (*dto.MessageResponse
 	}, nil
 }
 
-func (s *userService) GetUsers() ([]dto.UserResponse, error) {
-	users, err := s.userRepo.GetUsers()
+// GetUsers lists users. Contact fields follow each user's privacy settings; there is no
+// follow relation between users, so only public ones are shown to anyone but admins and
+// the user themself.
+func (s *userService) GetUsers(userInfo middleware.UserInfo, query dto.PageQuery) (*dto.PaginationResponse, error) {
+	page, err := pageRequest(query)
 	if err != nil {
 		return nil, err
 	}
 
-	var userResponses []dto.UserResponse
+	users, info, err := s.userRepo.GetUsers(page)
+	if err != nil {
+		return nil, err
+	}
+
+	userResponses := make([]dto.UserResponse, 0, len(users))
 	for _, user := range users {
-		userResponses = append(userResponses, dto.UserResponse{
+		response := dto.UserResponse{
 			ID:        user.ID,
 			FirstName: user.FirstName,
 			LastName:  user.LastName,
-			Email:     user.Email,
-			Phone:     user.Phone,
 			IsAdmin:   user.IsAdmin,
-		})
+		}
+		access := petAccessNone
+		if userInfo.IsAdmin || user.ID == userInfo.UserID {
+			access = petAccessOwner
+		}
+		if fieldVisible(user.EmailVisibility, access, false) {
+			response.Email = user.Email
+		}
+		if fieldVisible(user.PhoneVisibility, access, false) {
+			response.Phone = user.Phone
+		}
+		userResponses = append(userResponses, response)
 	}
 
-	return userResponses, nil
+	return &dto.PaginationResponse{
+		Data: userResponses,
+		Meta: pageMeta(page, info, users, func(user *models.User) (time.Time, string) {
+			return user.CreatedAt, user.ID
+		}),
+	}, nil
 }
 
 func (s *userService) ChangePassword(userInfo middleware.UserInfo, req dto.ChangePasswordRequest) (*dto.MessageResponse, error) {
@@ -290,8 +318,41 @@ func (s *userService) ChangePassword(userInfo middleware.UserInfo, req dto.Chang
 	}, nil
 }
 
+func (s *userService) UpdatePrivacy(userInfo middleware.UserInfo, req dto.UserPrivacyRequest) (*dto.UserResponse, error) {
+	user, err := s.userRepo.GetUserByID(userInfo.UserID)
+	if err != nil {
+		return nil, errors.New(utils.UserIsNotExist)
+	}
+
+	user.EmailVisibility = req.EmailVisibility
+	user.PhoneVisibility = req.PhoneVisibility
+	now := time.Now()
+	user.UpdatedAt = &now
+	user.UpdatedBy = userInfo.UserID
+
+	if err := s.userRepo.UpdateUser(user); err != nil {
+		return nil, err
+	}
+
+	return &dto.UserResponse{
+		ID:              user.ID,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		Email:           user.Email,
+		Phone:           user.Phone,
+		Avatar:          user.AvatarURL,
+		IsAdmin:         user.IsAdmin,
+		EmailVisibility: user.EmailVisibility,
+		PhoneVisibility: user.PhoneVisibility,
+	}, nil
+}
+
 // Comment methods
 func (s *userService) CreateComment(userInfo middleware.UserInfo, petID string, req dto.CommentRequest) (*dto.CommentResponse, error) {
+	if err := s.checkPetVisible(userInfo, petID); err != nil {
+		return nil, err
+	}
+
 	comment := &models.Comment{
 		Content:  req.Content,
 		PetID:    petID,
@@ -303,6 +364,8 @@ func (s *userService) CreateComment(userInfo middleware.UserInfo, petID string,
 		return nil, err
 	}
 
+	recordActivity(s.feedRepo, userInfo.UserID, petID, utils.ActivityComment, comment.ID, comment.Content, comment.CreatedAt)
+
 	return &dto.CommentResponse{
 		ID:        comment.ID,
 		Content:   comment.Content,
@@ -339,39 +402,69 @@ func (s *userService) EditComment(userInfo middleware.UserInfo, petID, commentID
 	}, nil
 }
 
-func (s *userService) GetCommentsByPetID(petID string) ([]dto.CommentResponse, error) {
-	results, err := s.userRepo.GetCommentsByPetID(petID)
+func (s *userService) GetCommentsByPetID(userInfo middleware.UserInfo, petID string, query dto.PageQuery) (*dto.PaginationResponse, error) {
+	if err := s.checkPetVisible(userInfo, petID); err != nil {
+		return nil, err
+	}
+
+	page, err := pageRequest(query)
 	if err != nil {
 		return nil, err
 	}
 
-	var comments []dto.CommentResponse
+	results, info, err := s.userRepo.GetCommentPage(petID, page)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]dto.CommentResponse, 0, len(results))
 	for _, r := range results {
-		comment := dto.CommentResponse{
-			ID:      r["id"].(string),
-			Content: r["content"].(string),
-			UserID:  r["user_id"].(string),
-		}
-		if firstName, ok := r["first_name"].(string); ok {
-			comment.FirstName = firstName
-		}
-		if lastName, ok := r["last_name"].(string); ok {
-			comment.LastName = lastName
-		}
-		if avatarURL, ok := r["avatar_url"].(string); ok {
-			comment.AvatarURL = avatarURL
-		}
-		if parentID, ok := r["parent_id"].(string); ok {
-			comment.ParentID = parentID
-		}
-		if createdAt, ok := r["created_at"].(time.Time); ok {
-			comment.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
-		}
-		if updatedAt, ok := r["updated_at"].(time.Time); ok {
-			comment.UpdatedAt = updatedAt.Format("2006-01-02 15:04:05")
-		}
-		comments = append(comments, comment)
+		comments = append(comments, toCommentResponse(r))
+	}
+
+	return &dto.PaginationResponse{
+		Data: comments,
+		Meta: pageMeta(page, info, results, func(r *map[string]interface{}) (time.Time, string) {
+			createdAt, _ := (*r)["created_at"].(time.Time)
+			id, _ := (*r)["id"].(string)
+			return createdAt, id
+		}),
+	}, nil
+}
+
+// checkPetVisible hides pets the user can't see, as if they didn't exist
+func (s *userService) checkPetVisible(userInfo middleware.UserInfo, petID string) error {
+	pet, err := s.petRepo.GetPetByID(petID)
+	if err != nil || resolvePetAccess(s.feedRepo, &userInfo, pet) == petAccessNone {
+		return errors.New(utils.PetIDNotExist)
 	}
+	return nil
+}
 
-	return comments, nil
+// toCommentResponse maps a comment row joined with its author
+func toCommentResponse(r map[string]interface{}) dto.CommentResponse {
+	comment := dto.CommentResponse{
+		ID:      r["id"].(string),
+		Content: r["content"].(string),
+		UserID:  r["user_id"].(string),
+	}
+	if firstName, ok := r["first_name"].(string); ok {
+		comment.FirstName = firstName
+	}
+	if lastName, ok := r["last_name"].(string); ok {
+		comment.LastName = lastName
+	}
+	if avatarURL, ok := r["avatar_url"].(string); ok {
+		comment.AvatarURL = avatarURL
+	}
+	if parentID, ok := r["parent_id"].(string); ok {
+		comment.ParentID = parentID
+	}
+	if createdAt, ok := r["created_at"].(time.Time); ok {
+		comment.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+	}
+	if updatedAt, ok := r["updated_at"].(time.Time); ok {
+		comment.UpdatedAt = updatedAt.Format("2006-01-02 15:04:05")
+	}
+	return comment
 }
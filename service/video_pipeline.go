@@ -0,0 +1,78 @@
+package service
+
+import (
+	"errors"
+	"io"
+	"log"
+	"os"
+	"pet-service/config"
+	"pet-service/storage"
+	"pet-service/utils"
+)
+
+// stagedVideo is an uploaded video spooled to a temp file so ffprobe and ffmpeg can
+// read it. It is removed once the poster has been extracted.
+type stagedVideo struct {
+	file *os.File
+	info *VideoInfo
+	hash string // hex SHA-256 of the file
+}
+
+func (v *stagedVideo) remove() {
+	v.file.Close()
+	if err := os.Remove(v.file.Name()); err != nil {
+		log.Printf("Failed to remove staged video %s: %v", v.file.Name(), err)
+	}
+}
+
+// stageVideo spools an upload to disk, hashing it on the way, and probes it, rejecting
+// files that aren't playable or run longer than VIDEO_MAX_DURATION_SECONDS. On success
+// the upload reads from the staged copy.
+func stageVideo(processor IVideoProcessor, file *UploadFile, field string) (*stagedVideo, error) {
+	tmp, err := os.CreateTemp("", "video-*")
+	if err != nil {
+		return nil, err
+	}
+	video := &stagedVideo{file: tmp}
+
+	contentHash := hashUpload(file)
+	if _, err := io.Copy(tmp, file.Reader); err != nil {
+		video.remove()
+		return nil, errors.New(utils.UploadFileUnreadable)
+	}
+	video.hash = contentHash()
+
+	video.info, err = processor.Probe(tmp.Name())
+	if err != nil {
+		video.remove()
+		log.Printf("Failed to probe video %s: %v", file.Name, err)
+		return nil, &utils.FileValidationError{Field: field, File: file.Name, Message: utils.VideoUnreadable}
+	}
+	if video.info.Duration > config.AppConfig.VideoMaxDuration {
+		video.remove()
+		return nil, &utils.FileValidationError{Field: field, File: file.Name, Message: utils.VideoTooLong}
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		video.remove()
+		return nil, err
+	}
+	file.Reader = tmp
+	return video, nil
+}
+
+// videoPosterObjectName is the key of a video's poster frame: media/<hash>-poster
+func videoPosterObjectName(objectName string) string {
+	return objectName + "-poster"
+}
+
+// playbackURL returns a presigned link to a stored video, whatever the pet's visibility:
+// presigned links honour Range headers, so players can stream and seek straight from storage
+func playbackURL(store storage.BlobStore, key string) string {
+	url, err := store.PresignGet(key, config.AppConfig.PresignedURLExpiry)
+	if err != nil {
+		log.Printf("Failed to presign video %s: %v", key, err)
+		return ""
+	}
+	return url
+}
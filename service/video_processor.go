@@ -0,0 +1,131 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"time"
+)
+
+// videoToolTimeout bounds a single ffprobe or ffmpeg run
+const videoToolTimeout = 60 * time.Second
+
+// VideoInfo is what probing a video file reports
+type VideoInfo struct {
+	Duration time.Duration
+	Width    int // as displayed, after any rotation
+	Height   int
+}
+
+type ffmpegVideoProcessor struct {
+	ffmpegPath  string
+	ffprobePath string
+}
+
+// NewVideoProcessor creates a video processor that shells out to ffprobe and ffmpeg
+func NewVideoProcessor(ffmpegPath, ffprobePath string) IVideoProcessor {
+	return &ffmpegVideoProcessor{
+		ffmpegPath:  ffmpegPath,
+		ffprobePath: ffprobePath,
+	}
+}
+
+// ffprobeOutput is the subset of `ffprobe -of json` output we read
+type ffprobeOutput struct {
+	Streams []struct {
+		Width    int               `json:"width"`
+		Height   int               `json:"height"`
+		Tags     map[string]string `json:"tags"`
+		SideData []struct {
+			Rotation int `json:"rotation"`
+		} `json:"side_data_list"`
+	} `json:"streams"`
+	Format struct {
+		Duration string `json:"duration"`
+	} `json:"format"`
+}
+
+func (p *ffmpegVideoProcessor) Probe(path string) (*VideoInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), videoToolTimeout)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, p.ffprobePath,
+		"-v", "error",
+		"-select_streams", "v:0",
+		"-show_entries", "stream=width,height:stream_tags=rotate:stream_side_data=rotation:format=duration",
+		"-of", "json",
+		path,
+	).Output()
+	if err != nil {
+		return nil, fmt.Errorf("ffprobe: %w", err)
+	}
+
+	var probe ffprobeOutput
+	if err := json.Unmarshal(out, &probe); err != nil {
+		return nil, err
+	}
+	if len(probe.Streams) == 0 || probe.Streams[0].Width == 0 || probe.Streams[0].Height == 0 {
+		return nil, errors.New("no video stream")
+	}
+	seconds, err := strconv.ParseFloat(probe.Format.Duration, 64)
+	if err != nil || seconds <= 0 {
+		return nil, errors.New("unknown duration")
+	}
+
+	stream := probe.Streams[0]
+	info := &VideoInfo{
+		Duration: time.Duration(seconds * float64(time.Second)),
+		Width:    stream.Width,
+		Height:   stream.Height,
+	}
+
+	// Phones record portrait video as landscape frames plus a rotation flag
+	rotation, _ := strconv.Atoi(stream.Tags["rotate"])
+	for _, side := range stream.SideData {
+		if side.Rotation != 0 {
+			rotation = side.Rotation
+		}
+	}
+	if rotation%180 != 0 {
+		info.Width, info.Height = info.Height, info.Width
+	}
+
+	return info, nil
+}
+
+func (p *ffmpegVideoProcessor) Poster(path string, at time.Duration) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), videoToolTimeout)
+	defer cancel()
+
+	var out, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.ffmpegPath,
+		"-v", "error",
+		"-ss", strconv.FormatFloat(at.Seconds(), 'f', 3, 64),
+		"-i", path,
+		"-frames:v", "1",
+		"-q:v", "2",
+		"-f", "image2pipe",
+		"-vcodec", "mjpeg",
+		"-",
+	)
+	cmd.Stdout = &out
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("ffmpeg: %w: %s", err, bytes.TrimSpace(stderr.Bytes()))
+	}
+	if out.Len() == 0 {
+		return nil, errors.New("ffmpeg produced no frame")
+	}
+
+	return out.Bytes(), nil
+}
+
+// posterTime picks the frame used as a video's poster: one second in, or the middle
+// of clips shorter than two seconds
+func posterTime(duration time.Duration) time.Duration {
+	return min(time.Second, duration/2)
+}
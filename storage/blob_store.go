@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"pet-service/config"
+	"time"
+)
+
+// Storage backends selectable with STORAGE_BACKEND
+const (
+	BackendMinio  = "minio"
+	BackendLocal  = "local"
+	BackendMemory = "memory"
+)
+
+// ErrNotExist is returned when an object is not in the store
+var ErrNotExist = errors.New("object does not exist")
+
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	LastModified time.Time
+}
+
+// BlobStore keeps the service's files (pet photos and videos, sighting photos, export
+// archives) under slash-separated keys such as pet/<pet_id>/<id>
+type BlobStore interface {
+	// Put stores an object; size may be -1 when unknown
+	Put(key string, reader io.Reader, size int64, contentType string) error
+	// Get opens an object for reading; the caller must close it
+	Get(key string) (io.ReadCloser, error)
+	// Delete removes an object; deleting a missing object is not an error
+	Delete(key string) error
+	Stat(key string) (*ObjectInfo, error)
+	// List returns the objects whose keys start with prefix
+	List(prefix string) ([]ObjectInfo, error)
+	// Copy duplicates an object within the store
+	Copy(srcKey, dstKey string) error
+	// PresignGet returns a time-limited download link that supports HTTP range requests
+	PresignGet(key string, expiry time.Duration) (string, error)
+	// PresignPost lets a client POST one object straight to the store. The policy pins
+	// the key and content type and caps the size; it returns the URL to post to and the
+	// form fields to send along with the file.
+	PresignPost(key, contentType string, maxSize int64, expiry time.Duration) (string, map[string]string, error)
+}
+
+// NewBlobStore opens the backend chosen by STORAGE_BACKEND
+func NewBlobStore(cfg *config.Config) (BlobStore, error) {
+	switch cfg.StorageBackend {
+	case BackendMinio:
+		return NewMinioStore(cfg)
+	case BackendLocal:
+		return NewLocalStore(cfg.StorageLocalPath)
+	case BackendMemory:
+		return NewMemoryStore(), nil
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.StorageBackend)
+	}
+}
+
+// IsNotExist reports whether err means the object is not in the store
+func IsNotExist(err error) bool {
+	return errors.Is(err, ErrNotExist)
+}
+
+// ReadAll loads a whole object into memory
+func ReadAll(store BlobStore, key string) ([]byte, error) {
+	object, err := store.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+	return io.ReadAll(object)
+}
@@ -0,0 +1,181 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"pet-service/utils"
+	"strings"
+	"time"
+)
+
+// localStore keeps objects as files under a directory, for local development. Content
+// types aren't recorded; Stat sniffs them from the file's first bytes.
+type localStore struct {
+	root string
+}
+
+// NewLocalStore stores objects under root, creating it if needed
+func NewLocalStore(root string) (BlobStore, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+	return &localStore{root: root}, nil
+}
+
+// path maps a key to its file, refusing keys that would escape the root
+func (s *localStore) path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid object key %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// Put writes to a temp file first so readers never see a partial object
+func (s *localStore) Put(key string, reader io.Reader, size int64, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, reader)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if size >= 0 && written != size {
+		return fmt.Errorf("wrote %d bytes of %s, expected %d", written, key, size)
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *localStore) Get(key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotExist, key)
+	}
+	return file, err
+}
+
+func (s *localStore) Delete(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *localStore) Stat(key string) (*ObjectInfo, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotExist, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	contentType, err := sniffFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectInfo{
+		Key:          key,
+		Size:         info.Size(),
+		ContentType:  contentType,
+		LastModified: info.ModTime(),
+	}, nil
+}
+
+func (s *localStore) List(prefix string) ([]ObjectInfo, error) {
+	// Only walk the directory the prefix points into
+	dir, err := s.path(prefix[:strings.LastIndex(prefix, "/")+1])
+	if err != nil {
+		dir = s.root
+	}
+
+	var objects []ObjectInfo
+	err = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".upload-") {
+			return nil
+		}
+		rel, err := filepath.Rel(s.root, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, ObjectInfo{Key: key, Size: info.Size(), LastModified: info.ModTime()})
+		return nil
+	})
+	return objects, err
+}
+
+func (s *localStore) Copy(srcKey, dstKey string) error {
+	src, err := s.Get(srcKey)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	return s.Put(dstKey, src, -1, "")
+}
+
+func (s *localStore) PresignGet(key string, expiry time.Duration) (string, error) {
+	return signedGetURL(key, expiry), nil
+}
+
+func (s *localStore) PresignPost(key, contentType string, maxSize int64, expiry time.Duration) (string, map[string]string, error) {
+	postURL, fields := signedPostPolicy(key, contentType, maxSize, expiry)
+	return postURL, fields, nil
+}
+
+// sniffFile guesses a file's content type from its first bytes
+func sniffFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	head := make([]byte, utils.SniffLen)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	return utils.SniffContentType(head[:n]), nil
+}
@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// memoryStore keeps objects in memory; everything is lost on restart. Meant for tests
+// and throwaway environments.
+type memoryStore struct {
+	mu      sync.RWMutex
+	objects map[string]memoryObject
+}
+
+type memoryObject struct {
+	data         []byte
+	contentType  string
+	lastModified time.Time
+}
+
+// memoryReader reads an object's bytes; it can seek so downloads support ranges
+type memoryReader struct {
+	*bytes.Reader
+}
+
+func (memoryReader) Close() error { return nil }
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() BlobStore {
+	return &memoryStore{objects: make(map[string]memoryObject)}
+}
+
+func (s *memoryStore) Put(key string, reader io.Reader, size int64, contentType string) error {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	if size >= 0 && int64(len(data)) != size {
+		return fmt.Errorf("read %d bytes of %s, expected %d", len(data), key, size)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = memoryObject{data: data, contentType: contentType, lastModified: time.Now()}
+	return nil
+}
+
+func (s *memoryStore) Get(key string) (io.ReadCloser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	object, ok := s.objects[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotExist, key)
+	}
+	// Stored bytes are never modified in place, so readers can share them
+	return memoryReader{bytes.NewReader(object.data)}, nil
+}
+
+func (s *memoryStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.objects, key)
+	return nil
+}
+
+func (s *memoryStore) Stat(key string) (*ObjectInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	object, ok := s.objects[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotExist, key)
+	}
+	info := object.info(key)
+	return &info, nil
+}
+
+func (s *memoryStore) List(prefix string) ([]ObjectInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var objects []ObjectInfo
+	for key, object := range s.objects {
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, object.info(key))
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	return objects, nil
+}
+
+func (s *memoryStore) Copy(srcKey, dstKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	object, ok := s.objects[srcKey]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotExist, srcKey)
+	}
+	object.lastModified = time.Now()
+	s.objects[dstKey] = object
+	return nil
+}
+
+func (s *memoryStore) PresignGet(key string, expiry time.Duration) (string, error) {
+	return signedGetURL(key, expiry), nil
+}
+
+func (s *memoryStore) PresignPost(key, contentType string, maxSize int64, expiry time.Duration) (string, map[string]string, error) {
+	postURL, fields := signedPostPolicy(key, contentType, maxSize, expiry)
+	return postURL, fields, nil
+}
+
+func (o memoryObject) info(key string) ObjectInfo {
+	return ObjectInfo{
+		Key:          key,
+		Size:         int64(len(o.data)),
+		ContentType:  o.contentType,
+		LastModified: o.lastModified,
+	}
+}
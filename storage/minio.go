@@ -1,100 +1,191 @@
 package storage
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"log"
+	"net/url"
 	"pet-service/config"
+	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 )
 
-type MinioClient struct {
+type minioStore struct {
 	client *minio.Client
 	bucket string
 }
 
-var minioInstance *MinioClient
-
-func InitMinio() error {
-	cfg := config.AppConfig
-
+// NewMinioStore connects to the MinIO bucket, creating it if needed
+func NewMinioStore(cfg *config.Config) (BlobStore, error) {
 	client, err := minio.New(cfg.MinioEndpoint, &minio.Options{
 		Creds:  credentials.NewStaticV4(cfg.MinioAccessKey, cfg.MinioSecretKey, ""),
 		Secure: cfg.MinioUseSSL,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Check if bucket exists, create if not
 	ctx := context.Background()
 	exists, err := client.BucketExists(ctx, cfg.MinioBucket)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !exists {
 		err = client.MakeBucket(ctx, cfg.MinioBucket, minio.MakeBucketOptions{})
 		if err != nil {
-			return err
+			return nil, err
 		}
 		log.Printf("Bucket %s created successfully", cfg.MinioBucket)
 	}
 
-	minioInstance = &MinioClient{
-		client: client,
-		bucket: cfg.MinioBucket,
+	// Objects are only reachable through presigned links, unless they are served from
+	// a public base URL, which may rely on the bucket's own policy
+	if cfg.MediaPublicBaseURL == "" {
+		policy, err := client.GetBucketPolicy(ctx, cfg.MinioBucket)
+		if err != nil {
+			return nil, err
+		}
+		if policy != "" {
+			if err := client.SetBucketPolicy(ctx, cfg.MinioBucket, ""); err != nil {
+				return nil, err
+			}
+			log.Printf("Removed the access policy of bucket %s so it stays private", cfg.MinioBucket)
+		}
 	}
 
 	log.Println("MinIO connection established")
-	return nil
+	return &minioStore{
+		client: client,
+		bucket: cfg.MinioBucket,
+	}, nil
 }
 
-func GetMinioClient() *MinioClient {
-	return minioInstance
+// Put streams an object of known size without loading it into memory
+func (m *minioStore) Put(key string, reader io.Reader, size int64, contentType string) error {
+	ctx := context.Background()
+
+	_, err := m.client.PutObject(ctx, m.bucket, key, reader, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	return err
 }
 
-func (m *MinioClient) UploadFile(objectName string, data []byte, contentType string) (string, error) {
+func (m *minioStore) Get(key string) (io.ReadCloser, error) {
 	ctx := context.Background()
 
-	reader := bytes.NewReader(data)
-	size := int64(len(data))
+	object, err := m.client.GetObject(ctx, m.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, minioError(err)
+	}
 
-	_, err := m.client.PutObject(ctx, m.bucket, objectName, reader, size, minio.PutObjectOptions{
-		ContentType: contentType,
-	})
+	// GetObject is lazy; Stat surfaces a missing object before any bytes are read
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		return nil, minioError(err)
+	}
+
+	return object, nil
+}
+
+func (m *minioStore) Delete(key string) error {
+	ctx := context.Background()
+
+	return m.client.RemoveObject(ctx, m.bucket, key, minio.RemoveObjectOptions{})
+}
+
+func (m *minioStore) Stat(key string) (*ObjectInfo, error) {
+	ctx := context.Background()
+
+	info, err := m.client.StatObject(ctx, m.bucket, key, minio.StatObjectOptions{})
 	if err != nil {
-		return "", err
+		return nil, minioError(err)
 	}
+	return &ObjectInfo{
+		Key:          info.Key,
+		Size:         info.Size,
+		ContentType:  info.ContentType,
+		LastModified: info.LastModified,
+	}, nil
+}
+
+func (m *minioStore) List(prefix string) ([]ObjectInfo, error) {
+	ctx := context.Background()
 
-	// Return the URL
-	url := fmt.Sprintf("%s/%s/%s", config.AppConfig.MinioEndpoint, m.bucket, objectName)
-	if config.AppConfig.MinioUseSSL {
-		url = fmt.Sprintf("https://%s/%s/%s", config.AppConfig.MinioEndpoint, m.bucket, objectName)
-	} else {
-		url = fmt.Sprintf("http://%s/%s/%s", config.AppConfig.MinioEndpoint, m.bucket, objectName)
+	var objects []ObjectInfo
+	for info := range m.client.ListObjects(ctx, m.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if info.Err != nil {
+			return nil, info.Err
+		}
+		objects = append(objects, ObjectInfo{
+			Key:          info.Key,
+			Size:         info.Size,
+			ContentType:  info.ContentType,
+			LastModified: info.LastModified,
+		})
 	}
+	return objects, nil
+}
+
+// Copy copies an object within the bucket without downloading it
+func (m *minioStore) Copy(srcKey, dstKey string) error {
+	ctx := context.Background()
 
-	return url, nil
+	_, err := m.client.CopyObject(ctx,
+		minio.CopyDestOptions{Bucket: m.bucket, Object: dstKey},
+		minio.CopySrcOptions{Bucket: m.bucket, Object: srcKey})
+	return minioError(err)
 }
 
-func (m *MinioClient) DownloadFile(objectName string) ([]byte, error) {
+func (m *minioStore) PresignGet(key string, expiry time.Duration) (string, error) {
 	ctx := context.Background()
 
-	object, err := m.client.GetObject(ctx, m.bucket, objectName, minio.GetObjectOptions{})
+	presigned, err := m.client.PresignedGetObject(ctx, m.bucket, key, expiry, url.Values{})
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	defer object.Close()
+	return presigned.String(), nil
+}
+
+// PresignPost uses a POST policy since a presigned PUT cannot limit the size
+func (m *minioStore) PresignPost(key, contentType string, maxSize int64, expiry time.Duration) (string, map[string]string, error) {
+	ctx := context.Background()
 
-	data, err := io.ReadAll(object)
+	policy := minio.NewPostPolicy()
+	if err := policy.SetBucket(m.bucket); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetKey(key); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetContentType(contentType); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetContentLengthRange(1, maxSize); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetExpires(time.Now().UTC().Add(expiry)); err != nil {
+		return "", nil, err
+	}
+
+	postURL, fields, err := m.client.PresignedPostPolicy(ctx, policy)
 	if err != nil {
-		return nil, err
+		return "", nil, err
 	}
+	return postURL.String(), fields, nil
+}
 
-	return data, nil
+// minioError maps MinIO's missing-object responses to ErrNotExist
+func minioError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return fmt.Errorf("%w: %v", ErrNotExist, err)
+	}
+	return err
 }
@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"pet-service/config"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The local and in-memory stores can't hand out links of their own, so the API serves
+// their objects under STORAGE_BASE_URL (see handler.BlobHandler). Links and upload
+// policies are signed with SECRET_KEY and expire like MinIO's presigned ones.
+
+// PostPolicy is an upload authorized by a signed POST policy
+type PostPolicy struct {
+	Key         string
+	ContentType string
+	MaxSize     int64
+}
+
+func signedGetURL(key string, expiry time.Duration) string {
+	expires := strconv.FormatInt(time.Now().Add(expiry).Unix(), 10)
+	query := url.Values{
+		"expires":   {expires},
+		"signature": {sign("GET", key, expires)},
+	}
+	return config.AppConfig.StorageBaseURL + "/" + escapeKey(key) + "?" + query.Encode()
+}
+
+func signedPostPolicy(key, contentType string, maxSize int64, expiry time.Duration) (string, map[string]string) {
+	expires := strconv.FormatInt(time.Now().Add(expiry).Unix(), 10)
+	size := strconv.FormatInt(maxSize, 10)
+	fields := map[string]string{
+		"key":          key,
+		"Content-Type": contentType,
+		"max_size":     size,
+		"expires":      expires,
+		"signature":    sign("POST", key, contentType, size, expires),
+	}
+	return config.AppConfig.StorageBaseURL, fields
+}
+
+// VerifySignedGet checks the expires and signature parameters of a download link
+func VerifySignedGet(key, expires, signature string) bool {
+	return !expired(expires) && hmac.Equal([]byte(signature), []byte(sign("GET", key, expires)))
+}
+
+// VerifySignedPost checks the form fields of an upload against their signature
+func VerifySignedPost(fields map[string]string) (*PostPolicy, bool) {
+	key, contentType, size, expires := fields["key"], fields["Content-Type"], fields["max_size"], fields["expires"]
+	if expired(expires) || !hmac.Equal([]byte(fields["signature"]), []byte(sign("POST", key, contentType, size, expires))) {
+		return nil, false
+	}
+	maxSize, err := strconv.ParseInt(size, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	return &PostPolicy{Key: key, ContentType: contentType, MaxSize: maxSize}, true
+}
+
+func sign(parts ...string) string {
+	mac := hmac.New(sha256.New, []byte(config.AppConfig.SecretKey))
+	mac.Write([]byte(strings.Join(parts, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func expired(expires string) bool {
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	return err != nil || time.Now().Unix() > unix
+}
+
+// escapeKey escapes each segment of a key for use in a URL path
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -8,4 +8,110 @@ const (
 	RoleAdmin  = "Admin"
 	RoleUser   = "User"
 	RoleEditor = "Editor"
+
+	// Permission constants
+	PermissionViewPet = "view_pet"
+	PermissionEditPet = "edit_pet"
+
+	// Pet activity kinds shown in the home feed
+	ActivityLifeEvent = "life_event"
+	ActivityMedia     = "media"
+	ActivityComment   = "comment"
+
+	// Visibility levels for pets and owner profile fields
+	VisibilityPublic    = "public"
+	VisibilityFollowers = "followers"
+	VisibilityPrivate   = "private"
+
+	// Follow statuses (followers-only pets require owner approval)
+	FollowStatusApproved = "approved"
+	FollowStatusPending  = "pending"
+
+	// Co-owner roles, from most to least privileged
+	PetRoleOwner     = "owner"
+	PetRoleCaretaker = "caretaker"
+	PetRoleViewer    = "viewer"
+
+	// Statuses of co-owner invites and ownership transfers
+	PetInvitePending   = "pending"
+	PetInviteAccepted  = "accepted"
+	PetInviteDeclined  = "declined"
+	PetInviteCancelled = "cancelled"
+
+	// Statuses of background pet data exports
+	ExportStatusPending = "pending"
+	ExportStatusRunning = "running"
+	ExportStatusReady   = "ready"
+	ExportStatusFailed  = "failed"
+
+	// Bulk pet import
+	ImportFormatCSV        = "csv"
+	ImportFormatJSON       = "json"
+	ImportStatusPending    = "pending"
+	ImportStatusRunning    = "running"
+	ImportStatusCompleted  = "completed"
+	ImportStatusFailed     = "failed"
+	ImportRowCreated       = "created"
+	ImportRowValid         = "valid" // dry run: the row would be created
+	ImportRowExists        = "exists"
+	ImportRowFailed        = "failed"
+	ImportMaxFileSize      = 10 << 20 // bytes
+	ImportMaxRows          = 5000
+	ImportProgressInterval = 25      // rows between progress updates
+	ImportAvatarMaxSize    = 5 << 20 // bytes
+
+	// Notification types
+	NotificationLostPetSighting = "lost_pet_sighting"
+	NotificationVaccinationDue  = "vaccination_due"
+	NotificationMedicationDue   = "medication_due"
+	NotificationPetInvite       = "pet_invite"
+	NotificationPetTransfer     = "pet_transfer"
+	NotificationExportReady     = "export_ready"
+	NotificationListLimit       = 100
+
+	// Medical record types that can trigger due-date reminders
+	ReminderRecordVaccination = "vaccination"
+	ReminderRecordMedication  = "medication"
+
+	// Species/breed catalog
+	CatalogEntitySpecies = "species"
+	CatalogEntityBreed   = "breed"
+	CatalogOther         = "other" // species/breed code that allows free text
+	CatalogDefaultLocale = "en"
+	CatalogSuggestLimit  = 10
+
+	// Pedigree tree depth (generations above the pet)
+	PedigreeDefaultDepth    = 3
+	PedigreeMaxDepth        = 6
+	PedigreeCycleCheckDepth = 64
+	SiblingFull             = "full"
+	SiblingHalf             = "half"
+
+	// Growth chart bucket sizes (PostgreSQL date_trunc units)
+	MeasurementBucketWeek  = "week"
+	MeasurementBucketMonth = "month"
+
+	// Media kinds, detected from the uploaded bytes
+	MediaTypeImage = "image"
+	MediaTypeVideo = "video"
+
+	// Processing statuses of gallery media; photos are processed in the background
+	MediaStatusProcessing = "processing"
+	MediaStatusReady      = "ready"
+	MediaStatusFailed     = "failed"
+
+	// Statuses of direct-to-storage gallery uploads
+	UploadStatusPending   = "pending"
+	UploadStatusCompleted = "completed"
+	UploadStatusFailed    = "failed"
+	UploadStatusExpired   = "expired"
+
+	// List pagination modes
+	PaginationOffset = "offset"
+	PaginationCursor = "cursor"
+	MaxPageSize      = 100
+
+	// Feed page size
+	FeedDefaultLimit = 20
+	FeedMaxLimit     = 50
 )
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+)
+
+// EncodeCursor builds an opaque keyset cursor from a sort timestamp and row ID
+func EncodeCursor(t time.Time, id string) string {
+	raw := t.UTC().Format(time.RFC3339Nano) + "|" + id
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor parses a cursor produced by EncodeCursor
+func DecodeCursor(cursor string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", errors.New(InvalidCursor)
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return time.Time{}, "", errors.New(InvalidCursor)
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return time.Time{}, "", errors.New(InvalidCursor)
+	}
+
+	return t, parts[1], nil
+}
+
+// PageCursor is a keyset position in a list ordered by (created_at, id), newest first.
+// Backward cursors page towards newer rows.
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        string
+	Backward  bool
+}
+
+// EncodePageCursor builds an opaque list cursor
+func EncodePageCursor(c PageCursor) string {
+	direction := "n"
+	if c.Backward {
+		direction = "p"
+	}
+	return direction + EncodeCursor(c.CreatedAt, c.ID)
+}
+
+// DecodePageCursor parses a cursor produced by EncodePageCursor
+func DecodePageCursor(cursor string) (*PageCursor, error) {
+	if len(cursor) < 2 || (cursor[0] != 'n' && cursor[0] != 'p') {
+		return nil, errors.New(InvalidCursor)
+	}
+
+	t, id, err := DecodeCursor(cursor[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &PageCursor{CreatedAt: t, ID: id, Backward: cursor[0] == 'p'}, nil
+}
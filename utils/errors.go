@@ -23,11 +23,38 @@ const (
 	// Validation errors
 	ErrCodeValidationFailed = "VALIDATION_ERROR"
 	ErrCodeInvalidInput     = "INVALID_INPUT"
+	ErrCodeInvalidCursor    = "INVALID_CURSOR"
 
 	// Resource errors
-	ErrCodeNotFound      = "NOT_FOUND"
-	ErrCodePetNotFound   = "PET_NOT_FOUND"
-	ErrCodeAlreadyExists = "ALREADY_EXISTS"
+	ErrCodeNotFound              = "NOT_FOUND"
+	ErrCodePetNotFound           = "PET_NOT_FOUND"
+	ErrCodeAlreadyExists         = "ALREADY_EXISTS"
+	ErrCodeFollowRequestNotFound = "FOLLOW_REQUEST_NOT_FOUND"
+	ErrCodeShareLinkNotFound     = "SHARE_LINK_NOT_FOUND"
+	ErrCodeNotificationNotFound  = "NOTIFICATION_NOT_FOUND"
+	ErrCodePetNotLost            = "PET_NOT_LOST"
+	ErrCodeMedicalRecordNotFound = "MEDICAL_RECORD_NOT_FOUND"
+	ErrCodeAppointmentNotFound   = "APPOINTMENT_NOT_FOUND"
+	ErrCodeMeasurementNotFound   = "MEASUREMENT_NOT_FOUND"
+	ErrCodeInvalidSpecies        = "INVALID_SPECIES"
+	ErrCodeInvalidBreed          = "INVALID_BREED"
+	ErrCodeInvalidParent         = "INVALID_PARENT"
+	ErrCodePedigreeCycle         = "PEDIGREE_CYCLE"
+	ErrCodeInviteNotFound        = "INVITE_NOT_FOUND"
+	ErrCodeTransferNotFound      = "TRANSFER_NOT_FOUND"
+	ErrCodePetMemorial           = "PET_MEMORIAL"
+	ErrCodeTributeNotFound       = "TRIBUTE_NOT_FOUND"
+	ErrCodeExportNotFound        = "EXPORT_NOT_FOUND"
+	ErrCodeExportNotReady        = "EXPORT_NOT_READY"
+	ErrCodeImportNotFound        = "IMPORT_NOT_FOUND"
+	ErrCodeInvalidImportFile     = "INVALID_IMPORT_FILE"
+	ErrCodeUploadTooLarge        = "UPLOAD_TOO_LARGE"
+	ErrCodeTooManyFiles          = "TOO_MANY_FILES"
+	ErrCodeUploadNotFound        = "UPLOAD_NOT_FOUND"
+	ErrCodeUploadIncomplete      = "UPLOAD_INCOMPLETE"
+	ErrCodeMediaNotFound         = "MEDIA_NOT_FOUND"
+	ErrCodeAlbumNotFound         = "ALBUM_NOT_FOUND"
+	ErrCodeMediaProcessing       = "MEDIA_PROCESSING"
 
 	// Server errors
 	ErrCodeInternalError = "INTERNAL_ERROR"
@@ -36,20 +63,86 @@ const (
 
 // Error messages
 const (
-	UserIsNotExist      = "User does not exist"
-	PasswordInvalid     = "Invalid password"
-	LoginError          = "Login error"
-	ErrorInvalidToken   = "Invalid token"
-	TokenExpired        = "Token has expired"
-	JTINotExist         = "JTI does not exist"
-	JTIInBlacklist      = "Token has been revoked"
-	ServiceError        = "Service error"
-	PetIDNotExist = "Pet ID does not exist"
-	EmailTaken    = "Email is already taken"
-	PermissionDenied    = "Permission denied"
-	UserHasNoPermission = "User has no permissions"
-	InvalidRequestBody  = "Invalid request body"
-	ValidationFailed    = "Validation failed"
+	UserIsNotExist        = "User does not exist"
+	PasswordInvalid       = "Invalid password"
+	LoginError            = "Login error"
+	ErrorInvalidToken     = "Invalid token"
+	TokenExpired          = "Token has expired"
+	JTINotExist           = "JTI does not exist"
+	JTIInBlacklist        = "Token has been revoked"
+	ServiceError          = "Service error"
+	PetIDNotExist         = "Pet ID does not exist"
+	EmailTaken            = "Email is already taken"
+	PermissionDenied      = "Permission denied"
+	UserHasNoPermission   = "User has no permissions"
+	InvalidRequestBody    = "Invalid request body"
+	ValidationFailed      = "Validation failed"
+	InvalidCursor         = "Invalid cursor"
+	CursorSortUnsupported = "Cursor pagination only supports sort=created_at with order=desc"
+	FollowRequestNotExist = "Follow request does not exist"
+	ShareLinkNotExist     = "Share link does not exist"
+	NotificationNotExist  = "Notification does not exist"
+	PetIsNotLost          = "Pet is not reported lost"
+	MedicalRecordNotExist = "Medical record does not exist"
+	AppointmentNotExist   = "Appointment does not exist"
+	InvalidDate           = "Invalid date, expected YYYY-MM-DD or YYYY-MM-DD HH:MM:SS"
+	MeasurementNotExist   = "Measurement does not exist"
+	MeasurementEmpty      = "At least one of weight_kg, body_condition_score or height_cm is required"
+	InvalidBucket         = "Bucket must be week or month"
+	InvalidSpecies        = "Unknown species; pick one from the catalog or use species_code \"other\""
+	InvalidBreed          = "Unknown breed for this species; pick one from the catalog or use breed_code \"other\""
+	InvalidCatalogCode    = "Catalog codes may only contain lowercase letters, digits and underscores"
+	CatalogCodeTaken      = "Catalog code is already taken"
+	InvalidParent         = "Parent pet does not exist"
+	ParentGenderMismatch  = "Sire must be male and dam must be female"
+	ParentSpeciesMismatch = "Parents must be the same species as the pet"
+	PedigreeCycle         = "A pet cannot be its own ancestor"
+	PetInviteNotExist     = "Invite does not exist"
+	PetTransferNotExist   = "Transfer does not exist"
+	PetMemberExists       = "This person already has access to the pet or a pending invite"
+	PetTransferPending    = "A transfer is already pending for this pet"
+	CannotInviteSelf      = "You cannot invite or transfer to yourself"
+	PetIsMemorial         = "This pet has passed away"
+	PetNotMemorial        = "Tributes can only be left on memorial profiles"
+	TributeNotExist       = "Tribute does not exist"
+	ExportNotExist        = "Export does not exist or has expired"
+	ExportNotReady        = "Export is not ready yet"
+	ExportInterrupted     = "Export was interrupted, please request it again"
+	ImportNotExist        = "Import does not exist"
+	ImportInterrupted     = "Import was interrupted; rows already imported are kept, so the file can be imported again"
+	ImportFormatInvalid   = "Import file must be CSV or JSON"
+	ImportFileInvalid     = "Import file could not be parsed"
+	ImportFileTooLarge    = "Import file is too large"
+	ImportFileEmpty       = "Import file has no rows"
+	ImportTooManyRows     = "Import file has too many rows"
+	ImportMissingColumn   = "CSV header must include a name column"
+	ImportDuplicateRow    = "external_id appears more than once in this file"
+	InvalidGender         = "Gender must be male, female, true or false"
+	AvatarURLInvalid      = "Avatar URL must be an http or https link"
+	AvatarDownloadFailed  = "Could not download the avatar"
+	AvatarTooLarge        = "Avatar is larger than 5 MB"
+	AvatarNotImage        = "Avatar URL does not point to an image"
+	AvatarStoreFailed     = "Could not store the avatar"
+	SightingPhotoFailed   = "Could not store the photo"
+	UploadFileTooLarge    = "File exceeds the maximum upload size"
+	UploadTooManyFiles    = "Too many files in one request"
+	UploadFileUnreadable  = "Cannot open file"
+	UploadStoreFailed     = "Some files could not be stored, so none were added; please try again"
+	UploadTypeNotAllowed  = "File type is not allowed here"
+	UploadTypeMismatch    = "File content does not match its Content-Type"
+	ImageTooLarge         = "Image dimensions are too large"
+	VideoUnreadable       = "File is not a playable video"
+	VideoTooLong          = "Video exceeds the maximum duration"
+	UploadNotExist        = "Upload does not exist or has expired"
+	UploadNotReceived     = "File has not been uploaded to storage yet"
+	MediaNotExist         = "Media does not exist"
+	AlbumNotExist         = "Album does not exist"
+	MediaProcessing       = "Media is still being processed"
+	MediaNoImage          = "Media has no image to use"
+	BlobNotExist          = "Object does not exist"
+	BlobLinkInvalid       = "Link is invalid or has expired"
+	BlobPolicyInvalid     = "Upload policy is invalid or has expired"
+	BlobFileMissing       = "Form must end with a file field"
 )
 
 // NewErrorResponse creates a standard error response
@@ -73,13 +166,13 @@ func NewValidationErrorResponse(err error) dto.ErrorResponse {
 // FormatValidationErrors formats Gin validation errors to user-friendly messages
 func FormatValidationErrors(err error) []dto.ErrorDetail {
 	var errors []dto.ErrorDetail
-	
+
 	if validationErrors, ok := err.(validator.ValidationErrors); ok {
 		for _, fieldError := range validationErrors {
 			var message string
 			field := fieldError.Field()
 			tag := fieldError.Tag()
-			
+
 			switch tag {
 			case "required":
 				message = fmt.Sprintf("%s is required", field)
@@ -89,21 +182,28 @@ func FormatValidationErrors(err error) []dto.ErrorDetail {
 				message = fmt.Sprintf("%s must be at least %s characters", field, fieldError.Param())
 			case "max":
 				message = fmt.Sprintf("%s must not exceed %s characters", field, fieldError.Param())
+			case "oneof":
+				message = fmt.Sprintf("%s must be one of: %s", field, fieldError.Param())
 			default:
 				message = fmt.Sprintf("%s is invalid", field)
 			}
-			
+
 			errors = append(errors, dto.ErrorDetail{
 				Field:   field,
 				Message: message,
 			})
 		}
+	} else if fileErr, ok := err.(*FileValidationError); ok {
+		errors = append(errors, dto.ErrorDetail{
+			Field:   fileErr.Field,
+			Message: fmt.Sprintf("%s: %s", fileErr.File, fileErr.Message),
+		})
 	} else {
 		errors = append(errors, dto.ErrorDetail{
 			Field:   "body",
 			Message: err.Error(),
 		})
 	}
-	
+
 	return errors
 }
@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"crypto/rand"
+	"encoding/base64"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,6 +26,15 @@ func VerifyPassword(password, hash string) bool {
 	return err == nil
 }
 
+// GenerateSlug generates an unguessable URL-safe slug (128 bits of randomness)
+func GenerateSlug() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
 // GenerateTransactionCode generates a transaction code
 func GenerateTransactionCode() string {
 	return "TXN" + time.Now().Format("20060102150405")
@@ -45,3 +56,12 @@ func ParseDateTime(dateStr string) (*time.Time, error) {
 
 	return nil, nil
 }
+
+// TruncateString cuts s to at most max characters without splitting a multi-byte rune
+func TruncateString(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
@@ -0,0 +1,262 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// ImageVariant is a resized copy rendered for every processed photo
+type ImageVariant struct {
+	Name    string
+	MaxEdge int // longest side in pixels; smaller photos are not upscaled
+}
+
+// ImageVariants are rendered largest first so each one is scaled from the previous
+var ImageVariants = []ImageVariant{
+	{Name: "large", MaxEdge: 1600},
+	{Name: "medium", MaxEdge: 800},
+	{Name: "thumb", MaxEdge: 256},
+}
+
+const (
+	// ImageMaxPixels rejects photos that would need too much memory to decode
+	ImageMaxPixels = 50_000_000
+
+	imageOriginalQuality = 90
+	imageVariantQuality  = 82
+)
+
+// ProcessedImage is a photo with its metadata removed, plus its JPEG variants
+type ProcessedImage struct {
+	Original    []byte
+	ContentType string
+	Variants    map[string][]byte
+}
+
+// ProcessImage strips EXIF/XMP metadata (GPS included) from a JPEG, PNG, GIF or WebP
+// photo and renders its variants. JPEGs are re-encoded upright using their EXIF
+// orientation, PNGs are re-encoded without ancillary chunks, WebP metadata chunks are
+// dropped in place and GIFs, which carry no EXIF, are kept as they are.
+func ProcessImage(data []byte) (*ProcessedImage, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width*config.Height > ImageMaxPixels {
+		return nil, errors.New(ImageTooLarge)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	processed := &ProcessedImage{Variants: make(map[string][]byte, len(ImageVariants))}
+	var buf bytes.Buffer
+	switch format {
+	case "jpeg":
+		img = orientImage(img, jpegOrientation(data))
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: imageOriginalQuality}); err != nil {
+			return nil, err
+		}
+		processed.Original, processed.ContentType = buf.Bytes(), "image/jpeg"
+	case "png":
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, err
+		}
+		processed.Original, processed.ContentType = buf.Bytes(), "image/png"
+	case "webp":
+		original, err := stripWebPMetadata(data)
+		if err != nil {
+			return nil, err
+		}
+		processed.Original, processed.ContentType = original, "image/webp"
+	case "gif":
+		processed.Original, processed.ContentType = data, "image/gif"
+	default:
+		return nil, errors.New(UploadTypeNotAllowed)
+	}
+
+	src := img
+	for _, variant := range ImageVariants {
+		src = scaleToFit(src, variant.MaxEdge)
+		var out bytes.Buffer
+		if err := jpeg.Encode(&out, src, &jpeg.Options{Quality: imageVariantQuality}); err != nil {
+			return nil, err
+		}
+		processed.Variants[variant.Name] = out.Bytes()
+	}
+
+	return processed, nil
+}
+
+// scaleToFit resizes img so its longest side is at most maxEdge, flattening any
+// transparency onto white since the variants are JPEGs
+func scaleToFit(img image.Image, maxEdge int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > maxEdge || h > maxEdge {
+		if w >= h {
+			w, h = maxEdge, max(1, h*maxEdge/b.Dx())
+		} else {
+			w, h = max(1, w*maxEdge/b.Dy()), maxEdge
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	if w == b.Dx() && h == b.Dy() {
+		draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Over)
+	} else {
+		draw.CatmullRom.Scale(dst, dst.Bounds(), img, b, draw.Over, nil)
+	}
+	return dst
+}
+
+// orientImage turns a photo upright according to its EXIF orientation (1-8)
+func orientImage(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	src := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(src, src.Bounds(), img, b.Min, draw.Src)
+
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // mirrored
+				dx, dy = w-1-x, y
+			case 3: // rotated 180
+				dx, dy = w-1-x, h-1-y
+			case 4: // mirrored vertically
+				dx, dy = x, h-1-y
+			case 5: // transposed
+				dx, dy = y, x
+			case 6: // rotated 90 clockwise
+				dx, dy = h-1-y, x
+			case 7: // transversed
+				dx, dy = h-1-y, w-1-x
+			case 8: // rotated 90 counter-clockwise
+				dx, dy = y, w-1-x
+			}
+			si, di := src.PixOffset(x, y), dst.PixOffset(dx, dy)
+			copy(dst.Pix[di:di+4], src.Pix[si:si+4])
+		}
+	}
+	return dst
+}
+
+// jpegOrientation reads the EXIF orientation tag of a JPEG, or 1 when it has none
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		// Metadata segments come before the image data
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+		size := int(binary.BigEndian.Uint16(data[i+2 : i+4]))
+		end := i + 2 + size
+		if size < 2 || end > len(data) {
+			return 1
+		}
+		segment := data[i+4 : end]
+		if marker == 0xE1 && len(segment) > 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return exifOrientation(segment[6:])
+		}
+		i = end
+	}
+	return 1
+}
+
+// exifOrientation finds tag 0x0112 in the first IFD of a TIFF-structured EXIF block
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:8]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[ifd : ifd+2]))
+	for n := 0; n < count; n++ {
+		entry := ifd + 2 + n*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:entry+2]) == 0x0112 {
+			return int(order.Uint16(tiff[entry+8 : entry+10]))
+		}
+	}
+	return 1
+}
+
+// stripWebPMetadata drops the EXIF and XMP chunks of a WebP file and clears their
+// flags in the VP8X header, leaving the image data untouched
+func stripWebPMetadata(data []byte) ([]byte, error) {
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, errors.New(UploadTypeNotAllowed)
+	}
+
+	out := make([]byte, 12, len(data))
+	copy(out, data[:12])
+	for i := 12; i+8 <= len(data); {
+		fourCC := string(data[i : i+4])
+		size := int(binary.LittleEndian.Uint32(data[i+4 : i+8]))
+		end := i + 8 + size + size%2
+		if end > len(data) {
+			end = len(data)
+		}
+		switch fourCC {
+		case "EXIF", "XMP ":
+		case "VP8X":
+			chunk := append([]byte(nil), data[i:end]...)
+			if len(chunk) > 8 {
+				// Flags byte: bit 3 = EXIF, bit 2 = XMP
+				chunk[8] &^= 0x08 | 0x04
+			}
+			out = append(out, chunk...)
+		default:
+			out = append(out, data[i:end]...)
+		}
+		i = end
+	}
+
+	binary.LittleEndian.PutUint32(out[4:8], uint32(len(out)-8))
+	return out, nil
+}
@@ -17,6 +17,11 @@ func CreatedResponse(c *gin.Context, data interface{}) {
 	c.JSON(http.StatusCreated, data)
 }
 
+// AcceptedResponse sends a 202 Accepted JSON response for work that finishes in the background
+func AcceptedResponse(c *gin.Context, data interface{}) {
+	c.JSON(http.StatusAccepted, data)
+}
+
 // ErrorResponse sends an error JSON response with custom status code
 func ErrorResponse(c *gin.Context, statusCode int, code, message string) {
 	c.JSON(statusCode, dto.ErrorResponse{
@@ -50,6 +55,11 @@ func ConflictError(c *gin.Context, code, message string) {
 	ErrorResponse(c, http.StatusConflict, code, message)
 }
 
+// PayloadTooLargeError sends a 413 Request Entity Too Large error
+func PayloadTooLargeError(c *gin.Context, code, message string) {
+	ErrorResponse(c, http.StatusRequestEntityTooLarge, code, message)
+}
+
 // InternalServerError sends a 500 Internal Server Error
 func InternalServerError(c *gin.Context, code, message string) {
 	ErrorResponse(c, http.StatusInternalServerError, code, message)
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"net/http"
+	"path"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SniffLen is how many leading bytes SniffContentType looks at
+const SniffLen = 512
+
+// Content types accepted for uploads, matched against the detected type
+var (
+	ImageContentTypes = []string{"image/jpeg", "image/png", "image/gif", "image/webp"}
+	VideoContentTypes = []string{"video/mp4", "video/webm", "video/quicktime"}
+)
+
+// FileValidationError rejects one uploaded file; it is reported like a binding error
+// with the form field and file name in its details
+type FileValidationError struct {
+	Field   string
+	File    string
+	Message string
+}
+
+func (e *FileValidationError) Error() string {
+	return e.Message
+}
+
+// SniffContentType detects a file's type from its leading bytes. Besides what
+// http.DetectContentType knows it recognises HEIC photos and QuickTime videos,
+// which phones produce and the standard sniffer reports as octet-stream. HEIC is
+// detected so it can be refused clearly: the image pipeline cannot decode it.
+func SniffContentType(head []byte) string {
+	if len(head) >= 12 && string(head[4:8]) == "ftyp" {
+		switch string(head[8:12]) {
+		case "heic", "heix", "hevc", "hevx", "heim", "heis", "mif1", "msf1":
+			return "image/heic"
+		case "qt  ":
+			return "video/quicktime"
+		}
+	}
+	return NormalizeContentType(http.DetectContentType(head))
+}
+
+// NormalizeContentType lower-cases a Content-Type header, drops its parameters
+// and maps common aliases to the names SniffContentType returns
+func NormalizeContentType(contentType string) string {
+	if i := strings.IndexByte(contentType, ';'); i >= 0 {
+		contentType = contentType[:i]
+	}
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	switch contentType {
+	case "image/jpg", "image/pjpeg":
+		return "image/jpeg"
+	case "image/heif":
+		return "image/heic"
+	}
+	return contentType
+}
+
+// MediaKind is the Media.Type stored for a detected content type
+func MediaKind(contentType string) string {
+	if strings.HasPrefix(contentType, "video/") {
+		return MediaTypeVideo
+	}
+	return MediaTypeImage
+}
+
+// SanitizeFileName keeps the base name of a client-supplied file name, drops control
+// and path characters and shortens it to maxLen bytes, keeping the extension
+func SanitizeFileName(name string, maxLen int) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case r == utf8.RuneError, unicode.IsControl(r), unicode.Is(unicode.Cf, r):
+			return -1
+		case strings.ContainsRune(`<>:"/\|?*`, r):
+			return '_'
+		}
+		return r
+	}, name)
+	name = strings.Trim(strings.Join(strings.Fields(name), " "), ". ")
+	if name == "" {
+		return "file"
+	}
+
+	if len(name) > maxLen {
+		ext := path.Ext(name)
+		if len(ext) > maxLen/4 {
+			ext = ""
+		}
+		base := name[:len(name)-len(ext)]
+		base = base[:maxLen-len(ext)]
+		// Don't cut a multi-byte character in half
+		for !utf8.ValidString(base) {
+			base = base[:len(base)-1]
+		}
+		name = strings.TrimRight(base, ". ") + ext
+	}
+	return name
+}